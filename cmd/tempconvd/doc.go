@@ -0,0 +1,26 @@
+// Команда tempconvd - HTTP-сервис преобразования температур.
+//
+// Сервис предоставляет ту же семантику преобразований, что и пакет tempconv,
+// для программ на других языках. Все ответы передаются в формате JSON.
+//
+// # Запросы:
+//
+//	GET  /convert?value=25&from=C&to=F  преобразование одного значения
+//	POST /convert                       пакетное преобразование: массив
+//	                                    объектов {"value", "from", "to"}
+//	GET  /scales                        поддерживаемые шкалы и их абсолютные нули
+//	GET  /eval?expr=(72F-20C)+in+K      вычисление выражения пакета tempconv/expr
+//
+// Обозначения шкал разбираются функцией tempconv.ParseScale. При ошибке
+// возвращается объект {"error": "..."}: код 400 для некорректного запроса,
+// неизвестной шкалы и недопустимого выражения, код 422 для температуры ниже
+// абсолютного нуля и результата, не представимого конечным числом, код 405 для неподдерживаемого метода и 404 для
+// неизвестного пути. При пакетном преобразовании ошибка и ее код ("status")
+// указываются для каждого элемента; код ответа равен 400, если хотя бы один
+// элемент некорректен, и 422, если остальные ошибки - температуры ниже
+// абсолютного нуля.
+//
+// # Использование:
+//
+//	tempconvd -addr :8080
+package main
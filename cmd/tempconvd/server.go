@@ -0,0 +1,251 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+	"github.com/MiCkEyZzZ/tempconv/tempconv/expr"
+)
+
+const (
+	// maxBodySize - максимальный размер тела пакетного запроса
+	maxBodySize = 1 << 20
+	// maxBatchSize - максимальное число элементов пакетного запроса
+	maxBatchSize = 10000
+)
+
+// Ошибки запросов
+var (
+	errBadRequest = errors.New("некорректный запрос")
+	errOutOfRange = errors.New("результат вне диапазона чисел float64")
+)
+
+// conversion - элемент запроса на преобразование.
+type conversion struct {
+	// Value - преобразуемое значение; nil, если поле отсутствует в запросе
+	Value *float64 `json:"value"`
+	From  string   `json:"from"`
+	To    string   `json:"to"`
+}
+
+// result - результат преобразования.
+type result struct {
+	Value     float64 `json:"value"`
+	From      string  `json:"from"`
+	To        string  `json:"to"`
+	Result    float64 `json:"result"`
+	Formatted string  `json:"formatted"`
+}
+
+// failure - элемент пакетного ответа, который не удалось преобразовать.
+type failure struct {
+	Value  *float64 `json:"value,omitempty"`
+	From   string   `json:"from"`
+	To     string   `json:"to"`
+	Status int      `json:"status"`
+	Error  string   `json:"error"`
+}
+
+// scaleInfo - описание шкалы в ответе /scales.
+type scaleInfo struct {
+	Name         string  `json:"name"`
+	Symbol       string  `json:"symbol"`
+	AbsoluteZero float64 `json:"absolute_zero"`
+}
+
+// evaluation - результат вычисления выражения.
+type evaluation struct {
+	Expr      string  `json:"expr"`
+	Kind      string  `json:"kind"`
+	Value     float64 `json:"value"`
+	Scale     string  `json:"scale,omitempty"`
+	Formatted string  `json:"formatted"`
+}
+
+// newHandler возвращает обработчик HTTP-запросов сервиса.
+func newHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /convert", handleConvert)
+	mux.HandleFunc("POST /convert", handleBatch)
+	mux.HandleFunc("GET /scales", handleScales)
+	mux.HandleFunc("GET /eval", handleEval)
+
+	// Остальные методы и пути получают ответ с ошибкой в формате JSON, а не
+	// текстовый ответ http.ServeMux.
+	mux.HandleFunc("/convert", methodNotAllowed(http.MethodGet, http.MethodPost))
+	mux.HandleFunc("/scales", methodNotAllowed(http.MethodGet))
+	mux.HandleFunc("/eval", methodNotAllowed(http.MethodGet))
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "путь не найден: " + r.URL.Path})
+	})
+	return mux
+}
+
+// methodNotAllowed возвращает обработчик, отклоняющий методы, отличные от
+// allowed, с кодом 405.
+func methodNotAllowed(allowed ...string) http.HandlerFunc {
+	allow := strings.Join(allowed, ", ")
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Allow", allow)
+		writeJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "метод не поддерживается: " + r.Method})
+	}
+}
+
+// handleConvert преобразует одно значение из параметров запроса.
+func handleConvert(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	v, err := strconv.ParseFloat(q.Get("value"), 64)
+	if err != nil {
+		writeError(w, fmt.Errorf("%w: значение %q", errBadRequest, q.Get("value")))
+		return
+	}
+	res, err := convert(conversion{Value: &v, From: q.Get("from"), To: q.Get("to")})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+// handleBatch преобразует массив значений из тела запроса.
+func handleBatch(w http.ResponseWriter, r *http.Request) {
+	var batch []conversion
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err := dec.Decode(&batch); err != nil {
+		writeError(w, fmt.Errorf("%w: %v", errBadRequest, err))
+		return
+	}
+	if err := dec.Decode(&struct{}{}); err != io.EOF {
+		writeError(w, fmt.Errorf("%w: данные после массива", errBadRequest))
+		return
+	}
+	if len(batch) > maxBatchSize {
+		writeError(w, fmt.Errorf("%w: более %d элементов", errBadRequest, maxBatchSize))
+		return
+	}
+
+	// Код ответа определяется самой серьезной ошибкой: некорректный элемент
+	// (400) важнее температуры ниже абсолютного нуля (422).
+	status := http.StatusOK
+	results := make([]any, len(batch))
+	for i, c := range batch {
+		res, err := convert(c)
+		if err != nil {
+			code := statusOf(err)
+			results[i] = failure{Value: c.Value, From: c.From, To: c.To, Status: code, Error: err.Error()}
+			if status == http.StatusOK || code == http.StatusBadRequest {
+				status = code
+			}
+			continue
+		}
+		results[i] = res
+	}
+	writeJSON(w, status, results)
+}
+
+// handleScales возвращает список поддерживаемых шкал.
+func handleScales(w http.ResponseWriter, _ *http.Request) {
+	scales := make([]scaleInfo, 0, len(tempconv.Scales()))
+	for _, s := range tempconv.Scales() {
+		scales = append(scales, scaleInfo{
+			Name:         s.String(),
+			Symbol:       s.Symbol(),
+			AbsoluteZero: tempconv.ValueOf(s.AbsoluteZero()),
+		})
+	}
+	writeJSON(w, http.StatusOK, scales)
+}
+
+// handleEval вычисляет выражение из параметра expr.
+func handleEval(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query().Get("expr")
+	v, err := expr.Eval(q)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	res := evaluation{Expr: q, Kind: v.Kind.String(), Value: v.Float(), Formatted: v.String()}
+	if s := v.Scale(); s.Valid() {
+		res.Scale = s.String()
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+// convert выполняет одно преобразование с проверкой абсолютного нуля.
+// Результат, который не представим конечным числом (например, 1e308°C в
+// Фаренгейтах), отклоняется с ошибкой errOutOfRange.
+func convert(c conversion) (result, error) {
+	if c.Value == nil {
+		return result{}, fmt.Errorf("%w: не задано значение", errBadRequest)
+	}
+	v := *c.Value
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return result{}, fmt.Errorf("%w: значение %v", errBadRequest, v)
+	}
+	from, err := tempconv.ParseScale(c.From)
+	if err != nil {
+		return result{}, err
+	}
+	to, err := tempconv.ParseScale(c.To)
+	if err != nil {
+		return result{}, err
+	}
+	t, err := from.New(v)
+	if err != nil {
+		return result{}, err
+	}
+	out := to.Convert(t)
+	if r := tempconv.ValueOf(out); math.IsNaN(r) || math.IsInf(r, 0) {
+		return result{}, fmt.Errorf("%w: %v %v в шкале %v", errOutOfRange, v, from.Symbol(), to)
+	}
+	return result{
+		Value:     v,
+		From:      from.String(),
+		To:        to.String(),
+		Result:    tempconv.ValueOf(out),
+		Formatted: out.String(),
+	}, nil
+}
+
+// writeError отправляет ошибку с кодом, соответствующим ее виду.
+func writeError(w http.ResponseWriter, err error) {
+	writeJSON(w, statusOf(err), map[string]string{"error": err.Error()})
+}
+
+// statusOf возвращает код ответа для ошибки: 400 для некорректного запроса,
+// неизвестной шкалы и неверного формата, 422 для температуры ниже
+// абсолютного нуля и результата вне диапазона.
+func statusOf(err error) int {
+	switch {
+	case errors.Is(err, errBadRequest),
+		errors.Is(err, tempconv.ErrUnknownScale),
+		errors.Is(err, tempconv.ErrInvalidFormat):
+		return http.StatusBadRequest
+	case errors.Is(err, tempconv.ErrBelowAbsoluteZero),
+		errors.Is(err, errOutOfRange),
+		errors.Is(err, expr.ErrOutOfRange):
+		return http.StatusUnprocessableEntity
+	}
+	return http.StatusBadRequest
+}
+
+// writeJSON отправляет v в формате JSON с кодом status. Ответ кодируется до
+// отправки заголовка, поэтому ошибка кодирования возвращается клиенту с кодом
+// 500, а не пустым ответом с кодом status.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		status = http.StatusInternalServerError
+		data, _ = json.Marshal(map[string]string{"error": "ошибка кодирования ответа: " + err.Error()})
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write(append(data, '\n'))
+}
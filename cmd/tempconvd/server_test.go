@@ -0,0 +1,269 @@
+package main
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// almostEqual проверяет, что два числа почти равны с заданной погрешностью.
+func almostEqual(a, b float64, epsilon float64) bool {
+	return math.Abs(a-b) <= epsilon
+}
+
+// do выполняет запрос к обработчику сервиса и разбирает JSON-ответ в v.
+func do(t *testing.T, method, target, body string, v any) int {
+	t.Helper()
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	newHandler().ServeHTTP(rec, req)
+	if ct := rec.Header().Get("Content-Type"); v != nil && !strings.HasPrefix(ct, "application/json") {
+		t.Fatalf("Content-Type = %q, want application/json", ct)
+	}
+	if v != nil {
+		if err := json.Unmarshal(rec.Body.Bytes(), v); err != nil {
+			t.Fatalf("invalid JSON %q: %v", rec.Body.String(), err)
+		}
+	}
+	return rec.Code
+}
+
+// TestConvert проверяет преобразование одного значения.
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantResult float64
+	}{
+		{"C to F", "value=25&from=C&to=F", http.StatusOK, 77},
+		{"names and degree sign", "value=0&from=kelvin&to=%C2%B0C", http.StatusOK, -273.15},
+		{"Delisle", "value=100&from=C&to=De", http.StatusOK, 0},
+		{"missing value", "from=C&to=F", http.StatusBadRequest, 0},
+		{"not finite", "value=NaN&from=C&to=F", http.StatusBadRequest, 0},
+		{"unknown scale", "value=1&from=X&to=F", http.StatusBadRequest, 0},
+		{"below absolute zero", "value=-300&from=C&to=K", http.StatusUnprocessableEntity, 0},
+		{"result overflow", "value=1e308&from=C&to=F", http.StatusUnprocessableEntity, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body struct {
+				Result float64 `json:"result"`
+				Error  string  `json:"error"`
+			}
+			status := do(t, http.MethodGet, "/convert?"+tt.query, "", &body)
+			if status != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", status, tt.wantStatus, body.Error)
+			}
+			if status != http.StatusOK {
+				if body.Error == "" {
+					t.Error("error body is empty")
+				}
+				return
+			}
+			if !almostEqual(body.Result, tt.wantResult, 1e-9) {
+				t.Errorf("result = %v, want %v", body.Result, tt.wantResult)
+			}
+		})
+	}
+}
+
+// TestBatch проверяет пакетное преобразование.
+func TestBatch(t *testing.T) {
+	var results []struct {
+		Result    float64 `json:"result"`
+		Formatted string  `json:"formatted"`
+		Error     string  `json:"error"`
+	}
+	status := do(t, http.MethodPost, "/convert",
+		`[{"value":100,"from":"C","to":"F"},{"value":-1,"from":"K","to":"C"},{"value":491.67,"from":"R","to":"K"}]`,
+		&results)
+	if status != http.StatusUnprocessableEntity {
+		t.Errorf("status = %d, want %d", status, http.StatusUnprocessableEntity)
+	}
+	if len(results) != 3 {
+		t.Fatalf("got %d results, want 3", len(results))
+	}
+	if results[0].Formatted != "212.00°F" || results[0].Error != "" {
+		t.Errorf("results[0] = %+v, want 212.00°F", results[0])
+	}
+	if results[1].Error == "" {
+		t.Errorf("results[1] = %+v, want error", results[1])
+	}
+	if !almostEqual(results[2].Result, 273.15, 1e-9) {
+		t.Errorf("results[2].Result = %v, want 273.15", results[2].Result)
+	}
+
+	// Неизвестная шкала - некорректный запрос, даже если другие элементы
+	// ниже абсолютного нуля
+	var failures []struct {
+		Status int    `json:"status"`
+		Error  string `json:"error"`
+	}
+	status = do(t, http.MethodPost, "/convert",
+		`[{"value":-1,"from":"K","to":"C"},{"value":1,"from":"X","to":"C"}]`, &failures)
+	if status != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", status, http.StatusBadRequest)
+	}
+	if len(failures) != 2 || failures[0].Status != http.StatusUnprocessableEntity || failures[1].Status != http.StatusBadRequest {
+		t.Errorf("failures = %+v, want statuses 422 and 400", failures)
+	}
+
+	if status := do(t, http.MethodPost, "/convert", `[{"value":1,"from":"C","to":"K"}]`, &results); status != http.StatusOK {
+		t.Errorf("status = %d, want %d", status, http.StatusOK)
+	}
+	if status := do(t, http.MethodPost, "/convert", `{"value":1}`, nil); status != http.StatusBadRequest {
+		t.Errorf("status for object body = %d, want %d", status, http.StatusBadRequest)
+	}
+}
+
+// TestBatchInvalid проверяет отклонение неполных элементов, данных после
+// массива и результатов вне диапазона.
+func TestBatchInvalid(t *testing.T) {
+	var res struct {
+		Error string `json:"error"`
+	}
+	if status := do(t, http.MethodPost, "/convert", `[{"value":1,"from":"C","to":"F"}] garbage`, &res); status != http.StatusBadRequest {
+		t.Errorf("status for trailing data = %d, want %d", status, http.StatusBadRequest)
+	}
+	if res.Error == "" {
+		t.Errorf("error is empty")
+	}
+
+	var failures []struct {
+		Value  *float64 `json:"value"`
+		Status int      `json:"status"`
+		Error  string   `json:"error"`
+	}
+	status := do(t, http.MethodPost, "/convert",
+		`[{"from":"C","to":"F"},{"value":1e308,"from":"C","to":"F"}]`, &failures)
+	if status != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", status, http.StatusBadRequest)
+	}
+	if len(failures) != 2 {
+		t.Fatalf("got %d results, want 2", len(failures))
+	}
+	if f := failures[0]; f.Value != nil || f.Status != http.StatusBadRequest || f.Error == "" {
+		t.Errorf("failures[0] = %+v, want missing value with status 400", f)
+	}
+	if f := failures[1]; f.Status != http.StatusUnprocessableEntity || f.Error == "" {
+		t.Errorf("failures[1] = %+v, want status 422", f)
+	}
+}
+
+// TestWriteJSONError проверяет, что ошибка кодирования возвращается с кодом
+// 500 и телом в формате JSON.
+func TestWriteJSONError(t *testing.T) {
+	rec := httptest.NewRecorder()
+	writeJSON(rec, http.StatusOK, map[string]float64{"value": math.Inf(1)})
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusInternalServerError)
+	}
+	var res struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil || res.Error == "" {
+		t.Errorf("body = %q, want JSON error", rec.Body.String())
+	}
+}
+
+// TestScales проверяет список шкал и их абсолютные нули.
+func TestScales(t *testing.T) {
+	var scales []struct {
+		Name         string  `json:"name"`
+		Symbol       string  `json:"symbol"`
+		AbsoluteZero float64 `json:"absolute_zero"`
+	}
+	if status := do(t, http.MethodGet, "/scales", "", &scales); status != http.StatusOK {
+		t.Fatalf("status = %d, want %d", status, http.StatusOK)
+	}
+
+	want := map[string]float64{
+		"Celsius": -273.15, "Fahrenheit": -459.67, "Kelvin": 0, "Rankine": 0,
+		"Reaumur": -218.52, "Delisle": 559.725, "Newton": -90.1395,
+	}
+	if len(scales) != len(want) {
+		t.Fatalf("got %d scales, want %d", len(scales), len(want))
+	}
+	for _, s := range scales {
+		if !almostEqual(s.AbsoluteZero, want[s.Name], 1e-9) {
+			t.Errorf("%s absolute zero = %v, want %v", s.Name, s.AbsoluteZero, want[s.Name])
+		}
+	}
+}
+
+// TestEval проверяет вычисление выражений.
+func TestEval(t *testing.T) {
+	tests := []struct {
+		expr   string
+		status int
+		kind   string
+		value  float64
+		scale  string
+	}{
+		{"(72F - 20C) in K", http.StatusOK, "delta", 20.0 / 9, "Kelvin"},
+		{"25C + 5 deltaC", http.StatusOK, "temperature", 30, "Celsius"},
+		{"2 * 3", http.StatusOK, "number", 6, ""},
+		{"25C + 25C", http.StatusBadRequest, "", 0, ""},
+		{"0K - 1 deltaK", http.StatusUnprocessableEntity, "", 0, ""},
+		{"1e308*10", http.StatusUnprocessableEntity, "", 0, ""},
+		{"(1e308*10-1e308*10)", http.StatusUnprocessableEntity, "", 0, ""},
+		{"1e308C in F", http.StatusUnprocessableEntity, "", 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			var res struct {
+				Kind  string  `json:"kind"`
+				Value float64 `json:"value"`
+				Scale string  `json:"scale"`
+				Error string  `json:"error"`
+			}
+			status := do(t, http.MethodGet, "/eval?expr="+url.QueryEscape(tt.expr), "", &res)
+			if status != tt.status {
+				t.Fatalf("status = %d, want %d (%s)", status, tt.status, res.Error)
+			}
+			if status != http.StatusOK {
+				if res.Error == "" {
+					t.Errorf("error is empty")
+				}
+				return
+			}
+			if res.Kind != tt.kind || res.Scale != tt.scale || !almostEqual(res.Value, tt.value, 1e-9) {
+				t.Errorf("got %+v, want %s %v %s", res, tt.kind, tt.value, tt.scale)
+			}
+		})
+	}
+}
+
+// TestMethodNotAllowed проверяет отклонение неподдерживаемых методов.
+func TestMethodNotAllowed(t *testing.T) {
+	tests := []struct {
+		method, target string
+		status         int
+	}{
+		{http.MethodDelete, "/convert", http.StatusMethodNotAllowed},
+		{http.MethodPost, "/scales", http.StatusMethodNotAllowed},
+		{http.MethodPut, "/eval", http.StatusMethodNotAllowed},
+		{http.MethodGet, "/unknown", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.target, func(t *testing.T) {
+			var res struct {
+				Error string `json:"error"`
+			}
+			if status := do(t, tt.method, tt.target, "", &res); status != tt.status {
+				t.Errorf("status = %d, want %d", status, tt.status)
+			}
+			if res.Error == "" {
+				t.Errorf("error is empty")
+			}
+		})
+	}
+}
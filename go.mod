@@ -1,3 +1,5 @@
 module github.com/MiCkEyZzZ/tempconv
 
 go 1.23.3
+
+require google.golang.org/protobuf v1.36.12
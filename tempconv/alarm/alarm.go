@@ -0,0 +1,267 @@
+package alarm
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// Ошибки сигнализации
+var (
+	ErrInvalidConfig  = errors.New("недопустимая конфигурация тревоги")
+	ErrOutOfOrder     = errors.New("показание старше предыдущего")
+	ErrNilTemperature = errors.New("не задана температура")
+)
+
+// State - состояние тревоги.
+type State int
+
+// Состояния тревоги
+const (
+	// Normal - температура в допустимых пределах
+	Normal State = iota
+	// High - температура выше верхнего порога
+	High
+	// Low - температура ниже нижнего порога
+	Low
+)
+
+// String возвращает название состояния.
+func (s State) String() string {
+	switch s {
+	case Normal:
+		return "normal"
+	case High:
+		return "high"
+	case Low:
+		return "low"
+	}
+	return fmt.Sprintf("State(%d)", int(s))
+}
+
+// EventKind - вид события тревоги.
+type EventKind int
+
+// Виды событий
+const (
+	// Raised - тревога поднята
+	Raised EventKind = iota
+	// Cleared - тревога снята
+	Cleared
+)
+
+// String возвращает название вида события.
+func (k EventKind) String() string {
+	switch k {
+	case Raised:
+		return "raised"
+	case Cleared:
+		return "cleared"
+	}
+	return fmt.Sprintf("EventKind(%d)", int(k))
+}
+
+// Event - событие изменения состояния тревоги.
+type Event struct {
+	// Kind - вид события
+	Kind EventKind
+	// Level - порог, к которому относится событие (High или Low)
+	Level State
+	// Value - показание, на котором сработало событие
+	Value tempconv.Temperature
+	// Time - момент показания
+	Time time.Time
+}
+
+// String возвращает строковое представление события.
+func (e Event) String() string {
+	return fmt.Sprintf("%s %s: %v at %s", e.Level, e.Kind, e.Value, e.Time.Format(time.RFC3339))
+}
+
+// Config - параметры тревоги.
+type Config struct {
+	// High - верхний порог; nil, если не используется
+	High tempconv.Temperature
+	// Low - нижний порог; nil, если не используется
+	Low tempconv.Temperature
+	// Hysteresis - ширина полосы гистерезиса; нулевое значение отключает гистерезис
+	Hysteresis tempconv.Delta
+	// MinDuration - время, в течение которого условие должно сохраняться,
+	// прежде чем тревога будет поднята или снята
+	MinDuration time.Duration
+}
+
+// Alarm - конечный автомат тревоги по температурным порогам. Методы Alarm
+// безопасны для одновременного использования из нескольких горутин.
+type Alarm struct {
+	mu         sync.Mutex
+	high, low  float64 // пороги в кельвинах
+	hasHigh    bool
+	hasLow     bool
+	hysteresis float64 // ширина полосы в кельвинах
+	minDur     time.Duration
+
+	state   State
+	pending bool      // условие смены состояния выполняется
+	target  State     // состояние, в которое ожидается переход
+	since   time.Time // момент, с которого выполняется условие
+	last    time.Time // момент последнего показания
+
+	callbacks []func(Event)
+	channels  []chan<- Event
+}
+
+// New создает тревогу с параметрами cfg. Должен быть задан хотя бы один
+// порог; верхний порог должен быть выше нижнего более чем на две ширины
+// полосы гистерезиса, чтобы полосы не перекрывались.
+func New(cfg Config) (*Alarm, error) {
+	a := &Alarm{minDur: cfg.MinDuration}
+	if cfg.High == nil && cfg.Low == nil {
+		return nil, fmt.Errorf("%w: не задан ни один порог", ErrInvalidConfig)
+	}
+	if cfg.MinDuration < 0 {
+		return nil, fmt.Errorf("%w: отрицательная длительность %v", ErrInvalidConfig, cfg.MinDuration)
+	}
+	if cfg.Hysteresis != (tempconv.Delta{}) {
+		if !cfg.Hysteresis.Scale.Valid() {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidConfig, tempconv.ErrUnknownScale)
+		}
+		a.hysteresis = cfg.Hysteresis.Kelvins()
+		if a.hysteresis < 0 {
+			return nil, fmt.Errorf("%w: отрицательный гистерезис %v", ErrInvalidConfig, cfg.Hysteresis)
+		}
+	}
+	if cfg.High != nil {
+		a.high, a.hasHigh = float64(cfg.High.ToKelvin()), true
+	}
+	if cfg.Low != nil {
+		a.low, a.hasLow = float64(cfg.Low.ToKelvin()), true
+	}
+	if a.hasHigh && a.hasLow && a.high-a.low <= 2*a.hysteresis {
+		return nil, fmt.Errorf("%w: верхний порог %v слишком близок к нижнему %v", ErrInvalidConfig, cfg.High, cfg.Low)
+	}
+	return a, nil
+}
+
+// OnEvent регистрирует функцию обратного вызова для событий тревоги. Функции
+// вызываются синхронно из Update после освобождения внутренней блокировки.
+func (a *Alarm) OnEvent(f func(Event)) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.callbacks = append(a.callbacks, f)
+}
+
+// Notify регистрирует канал для событий тревоги. Как и в signal.Notify,
+// отправка в канал не блокируется: если в канале нет места, событие для
+// этого канала отбрасывается, поэтому канал должен быть буферизован.
+func (a *Alarm) Notify(ch chan<- Event) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.channels = append(a.channels, ch)
+}
+
+// State возвращает текущее состояние тревоги.
+func (a *Alarm) State() State {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Update обрабатывает показание t, полученное в момент at. Показания должны
+// поступать в порядке неубывания времени.
+func (a *Alarm) Update(t tempconv.Temperature, at time.Time) error {
+	if t == nil {
+		return ErrNilTemperature
+	}
+
+	a.mu.Lock()
+	if !a.last.IsZero() && at.Before(a.last) {
+		a.mu.Unlock()
+		return fmt.Errorf("%w: %v раньше %v", ErrOutOfOrder, at, a.last)
+	}
+	a.last = at
+	events := a.step(t, at)
+	callbacks, channels := a.callbacks, a.channels
+	a.mu.Unlock()
+
+	for _, e := range events {
+		for _, f := range callbacks {
+			f(e)
+		}
+		for _, ch := range channels {
+			select {
+			case ch <- e:
+			default:
+			}
+		}
+	}
+	return nil
+}
+
+// step выполняет переход автомата и возвращает возникшие события.
+func (a *Alarm) step(t tempconv.Temperature, at time.Time) []Event {
+	k := float64(t.ToKelvin())
+	var events []Event
+
+	if a.state != Normal {
+		if !a.debounce(a.clears(k), Normal, at) {
+			return nil
+		}
+		events = append(events, Event{Kind: Cleared, Level: a.state, Value: t, Time: at})
+		a.state = Normal
+	}
+
+	next := a.violation(k)
+	if next == Normal {
+		a.pending = false
+		return events
+	}
+	if a.debounce(true, next, at) {
+		a.state = next
+		events = append(events, Event{Kind: Raised, Level: next, Value: t, Time: at})
+	}
+	return events
+}
+
+// violation возвращает порог, нарушенный температурой k (в кельвинах).
+func (a *Alarm) violation(k float64) State {
+	switch {
+	case a.hasHigh && k > a.high:
+		return High
+	case a.hasLow && k < a.low:
+		return Low
+	}
+	return Normal
+}
+
+// clears сообщает, вернулась ли температура k (в кельвинах) в допустимые
+// пределы с учетом полосы гистерезиса.
+func (a *Alarm) clears(k float64) bool {
+	if a.state == High {
+		return k <= a.high-a.hysteresis
+	}
+	return k >= a.low+a.hysteresis
+}
+
+// debounce отслеживает, как долго выполняется условие cond перехода в
+// состояние target, и сообщает, выполняется ли оно не менее минимальной
+// длительности. Если ожидаемое состояние меняется (например, температура
+// перескочила от верхнего порога к нижнему), отсчет начинается заново. После
+// срабатывания отслеживание сбрасывается.
+func (a *Alarm) debounce(cond bool, target State, at time.Time) bool {
+	if !cond {
+		a.pending = false
+		return false
+	}
+	if !a.pending || a.target != target {
+		a.pending, a.target, a.since = true, target, at
+	}
+	if at.Sub(a.since) < a.minDur {
+		return false
+	}
+	a.pending = false
+	return true
+}
@@ -0,0 +1,225 @@
+package alarm
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// t0 - момент первого тестового показания
+var t0 = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// feed передает тревоге показания в градусах Цельсия с шагом в одну минуту и
+// возвращает полученные события.
+func feed(t *testing.T, a *Alarm, values ...float64) []Event {
+	t.Helper()
+	var events []Event
+	a.OnEvent(func(e Event) { events = append(events, e) })
+	for i, v := range values {
+		if err := a.Update(tempconv.Celsius(v), t0.Add(time.Duration(i)*time.Minute)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	return events
+}
+
+// TestNewErrors проверяет отклонение некорректных конфигураций.
+func TestNewErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+	}{
+		{"no thresholds", Config{}},
+		{"negative duration", Config{High: tempconv.Celsius(30), MinDuration: -time.Second}},
+		{"unknown hysteresis scale", Config{High: tempconv.Celsius(30), Hysteresis: tempconv.Delta{Value: 1}}},
+		{"negative hysteresis", Config{High: tempconv.Celsius(30), Hysteresis: tempconv.Delta{Value: -1, Scale: tempconv.ScaleCelsius}}},
+		{"inverted thresholds", Config{High: tempconv.Celsius(10), Low: tempconv.Celsius(20)}},
+		{"overlapping bands", Config{
+			High:       tempconv.Celsius(22),
+			Low:        tempconv.Celsius(20),
+			Hysteresis: tempconv.Delta{Value: 1, Scale: tempconv.ScaleCelsius},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(tt.cfg); !errors.Is(err, ErrInvalidConfig) {
+				t.Errorf("New() error = %v, want %v", err, ErrInvalidConfig)
+			}
+		})
+	}
+}
+
+// TestHysteresis проверяет, что тревога снимается только после выхода из
+// полосы гистерезиса, заданной в другой шкале.
+func TestHysteresis(t *testing.T) {
+	a, err := New(Config{
+		High:       tempconv.Fahrenheit(86),                                     // 30°C
+		Hysteresis: tempconv.Delta{Value: 3.6, Scale: tempconv.ScaleFahrenheit}, // 2°C
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	events := feed(t, a, 29, 31, 29, 30.5, 27.9)
+	want := []struct {
+		kind  EventKind
+		value float64
+	}{
+		{Raised, 31},
+		{Cleared, 27.9},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events %v, want %d", len(events), events, len(want))
+	}
+	for i, w := range want {
+		if events[i].Kind != w.kind || events[i].Level != High || events[i].Value != tempconv.Celsius(w.value) {
+			t.Errorf("event %d = %v, want %v high at %v", i, events[i], w.kind, w.value)
+		}
+	}
+	if a.State() != Normal {
+		t.Errorf("State() = %v, want %v", a.State(), Normal)
+	}
+}
+
+// TestMinDuration проверяет подавление кратковременных выбросов.
+func TestMinDuration(t *testing.T) {
+	a, _ := New(Config{
+		Low:         tempconv.Kelvin(273.15),
+		MinDuration: 2 * time.Minute,
+	})
+
+	// Выброс длиной в одну минуту игнорируется, тревога поднимается на
+	// третьей минуте непрерывного нарушения и снимается через две минуты
+	// после возврата.
+	events := feed(t, a, 1, -1, 1, -1, -2, -3, 2, -1, 2, 3, 4)
+	want := []struct {
+		kind EventKind
+		at   time.Duration
+	}{
+		{Raised, 5 * time.Minute},
+		{Cleared, 10 * time.Minute},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events %v, want %d", len(events), events, len(want))
+	}
+	for i, w := range want {
+		if events[i].Kind != w.kind || events[i].Level != Low || !events[i].Time.Equal(t0.Add(w.at)) {
+			t.Errorf("event %d = %v, want %v low at %v", i, events[i], w.kind, t0.Add(w.at))
+		}
+	}
+}
+
+// TestHighToLow проверяет переход из верхней тревоги сразу в нижнюю.
+func TestHighToLow(t *testing.T) {
+	a, _ := New(Config{High: tempconv.Celsius(30), Low: tempconv.Celsius(10)})
+
+	events := feed(t, a, 35, 5)
+	want := []struct {
+		kind  EventKind
+		level State
+	}{
+		{Raised, High},
+		{Cleared, High},
+		{Raised, Low},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events %v, want %d", len(events), events, len(want))
+	}
+	for i, w := range want {
+		if events[i].Kind != w.kind || events[i].Level != w.level {
+			t.Errorf("event %d = %v, want %v %v", i, events[i], w.level, w.kind)
+		}
+	}
+	if a.State() != Low {
+		t.Errorf("State() = %v, want %v", a.State(), Low)
+	}
+}
+
+// TestPendingHighToLow проверяет, что отсчет минимальной длительности
+// начинается заново, если ожидаемая тревога сменилась с верхней на нижнюю.
+func TestPendingHighToLow(t *testing.T) {
+	a, _ := New(Config{
+		High:        tempconv.Celsius(30),
+		Low:         tempconv.Celsius(10),
+		MinDuration: 2 * time.Minute,
+	})
+
+	// Верхний порог нарушается на 0-й и 1-й минутах, со 2-й минуты -
+	// нижний. Нижняя тревога поднимается только на 4-й минуте.
+	events := feed(t, a, 35, 35, 5, 5, 5)
+	if len(events) != 1 {
+		t.Fatalf("got %d events %v, want 1", len(events), events)
+	}
+	if e := events[0]; e.Kind != Raised || e.Level != Low || !e.Time.Equal(t0.Add(4*time.Minute)) {
+		t.Errorf("event = %v, want low raised at %v", e, t0.Add(4*time.Minute))
+	}
+}
+
+// TestClearThenViolation проверяет, что после снятия тревоги новая тревога
+// выдерживает полную минимальную длительность.
+func TestClearThenViolation(t *testing.T) {
+	a, _ := New(Config{
+		High:        tempconv.Celsius(30),
+		Low:         tempconv.Celsius(10),
+		MinDuration: 2 * time.Minute,
+	})
+
+	// Верхняя тревога поднимается на 2-й минуте, условие снятия выполняется
+	// с 3-й минуты и срабатывает на 5-й, когда температура уже ниже нижнего
+	// порога. Нижняя тревога поднимается на 7-й минуте.
+	events := feed(t, a, 35, 35, 35, 20, 20, 5, 5, 5)
+	want := []struct {
+		kind  EventKind
+		level State
+		at    time.Duration
+	}{
+		{Raised, High, 2 * time.Minute},
+		{Cleared, High, 5 * time.Minute},
+		{Raised, Low, 7 * time.Minute},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events %v, want %d", len(events), events, len(want))
+	}
+	for i, w := range want {
+		if events[i].Kind != w.kind || events[i].Level != w.level || !events[i].Time.Equal(t0.Add(w.at)) {
+			t.Errorf("event %d = %v, want %v %v at %v", i, events[i], w.level, w.kind, t0.Add(w.at))
+		}
+	}
+}
+
+// TestNotify проверяет доставку событий в канал.
+func TestNotify(t *testing.T) {
+	a, _ := New(Config{High: tempconv.Celsius(30)})
+	ch := make(chan Event, 1)
+	a.Notify(ch)
+
+	if err := a.Update(tempconv.Celsius(31), t0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	select {
+	case e := <-ch:
+		if e.Kind != Raised || e.Level != High {
+			t.Errorf("event = %v, want high raised", e)
+		}
+	default:
+		t.Fatal("no event delivered")
+	}
+}
+
+// TestUpdateErrors проверяет ошибки при обработке показаний.
+func TestUpdateErrors(t *testing.T) {
+	a, _ := New(Config{High: tempconv.Celsius(30)})
+
+	if err := a.Update(nil, t0); !errors.Is(err, ErrNilTemperature) {
+		t.Errorf("Update(nil) error = %v, want %v", err, ErrNilTemperature)
+	}
+	if err := a.Update(tempconv.Celsius(20), t0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := a.Update(tempconv.Celsius(20), t0.Add(-time.Second)); !errors.Is(err, ErrOutOfOrder) {
+		t.Errorf("Update() error = %v, want %v", err, ErrOutOfOrder)
+	}
+}
@@ -0,0 +1,28 @@
+// Пакет alarm содержит сигнализацию о выходе температуры за пороги с
+// гистерезисом и подавлением дребезга.
+//
+// Alarm принимает показания температуры в любой шкале и сравнивает их с
+// верхним и нижним порогами, которые также могут быть заданы в любых шкалах.
+// Ширина полосы гистерезиса - это разность температур (tempconv.Delta):
+// тревога по верхнему порогу снимается, только когда температура опустится
+// ниже порога на ширину полосы. Минимальная длительность задает время, в
+// течение которого условие должно сохраняться, прежде чем тревога будет
+// поднята или снята.
+//
+// О событиях можно узнавать через функции обратного вызова (OnEvent) или
+// каналы (Notify).
+//
+// # Пример использования:
+//
+//	a, err := alarm.New(alarm.Config{
+//	    High:        tempconv.Celsius(80),
+//	    Hysteresis:  tempconv.Delta{Value: 2, Scale: tempconv.ScaleCelsius},
+//	    MinDuration: 30 * time.Second,
+//	})
+//	if err != nil {
+//	    fmt.Println("Ошибка:", err)
+//	    return
+//	}
+//	a.OnEvent(func(e alarm.Event) { fmt.Println(e) })
+//	_ = a.Update(tempconv.Fahrenheit(180), time.Now())
+package alarm
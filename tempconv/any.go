@@ -0,0 +1,120 @@
+package tempconv
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+)
+
+// AnyTemperature - температура в шкале, выбранной во время выполнения, для
+// хранения в базе данных вместе со шкалой. В отличие от Celsius, Kelvin и
+// других типов, которые хранятся как числа, AnyTemperature сохраняет значение
+// в той шкале, в которой оно было введено.
+//
+// AnyTemperature хранится в одном текстовом столбце в виде "25 C" (см.
+// FormatTemperature); при чтении конкретный тип восстанавливается по
+// обозначению шкалы. Для хранения в двух столбцах (число и шкала) служат
+// методы Columns и NewAnyTemperature, а тип Scale сам реализует sql.Scanner и
+// driver.Valuer. Нулевое значение соответствует NULL.
+//
+// Температура хранится в именованном поле, а не встраивается, чтобы у
+// нулевого значения и значения, прочитанного из NULL, не было методов
+// интерфейса Temperature, вызов которых привел бы к панике. Перед
+// преобразованием проверьте Valid.
+type AnyTemperature struct {
+	// Temperature - температура или nil, если значение не задано
+	Temperature Temperature
+	// DefaultScale - шкала, в которой при разборе текста, флагов и файлов
+	// конфигурации задано число без обозначения шкалы; нулевое значение
+	// означает шкалу Цельсия. Задайте поле до разбора, чтобы выбрать шкалу
+	// для отдельного значения, не затрагивая остальные.
+	//
+	// Поле относится только к разбору и не записывается: MarshalText и
+	// MarshalJSON всегда записывают температуру с обозначением ее шкалы, и при
+	// разборе записанного значения DefaultScale не используется. Разбор
+	// заменяет только Temperature, поэтому DefaultScale получателя сохраняется,
+	// а получатель с нулевым значением разбирает числа без шкалы в градусах
+	// Цельсия.
+	DefaultScale Scale
+}
+
+// NewAnyTemperature создает температуру v в шкале s с проверкой абсолютного
+// нуля.
+func NewAnyTemperature(v float64, s Scale) (AnyTemperature, error) {
+	t, err := s.New(v)
+	if err != nil {
+		return AnyTemperature{}, err
+	}
+	return AnyTemperature{Temperature: t}, nil
+}
+
+// Valid сообщает, задана ли температура (не NULL).
+func (a AnyTemperature) Valid() bool { return a.Temperature != nil }
+
+// Columns возвращает числовое значение и шкалу температуры для хранения в
+// двух столбцах. Для незаданной температуры возвращаются NaN и нулевая шкала.
+func (a AnyTemperature) Columns() (float64, Scale) {
+	if a.Temperature == nil {
+		return math.NaN(), 0
+	}
+	return ValueOf(a.Temperature), ScaleOf(a.Temperature)
+}
+
+// String возвращает строковое представление температуры или "<nil>", если
+// температура не задана.
+func (a AnyTemperature) String() string {
+	if a.Temperature == nil {
+		return "<nil>"
+	}
+	return a.Temperature.String()
+}
+
+// Scan читает температуру со шкалой из текстового значения столбца, например
+// "25 C" или "77.00 Fahrenheit". NULL дает незаданную температуру.
+func (a *AnyTemperature) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+		a.Temperature = nil
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("%w: тип %T", ErrInvalidSQLValue, src)
+	}
+	t, err := ParseTemperature(s)
+	if err != nil {
+		return err
+	}
+	a.Temperature = t
+	return nil
+}
+
+// Value возвращает температуру со шкалой в текстовом виде для записи в базу
+// данных или NULL, если температура не задана.
+func (a AnyTemperature) Value() (driver.Value, error) {
+	if a.Temperature == nil {
+		return nil, nil
+	}
+	v, s := a.Columns()
+	if !s.Valid() {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownScale, a.Temperature.ScaleName())
+	}
+	if math.IsNaN(v) {
+		return nil, fmt.Errorf("%w: NaN", ErrInvalidSQLValue)
+	}
+	if _, err := s.New(v); err != nil {
+		return nil, err
+	}
+	return FormatTemperature(a.Temperature), nil
+}
+
+// defaultScale возвращает шкалу для чисел без обозначения шкалы.
+func (a AnyTemperature) defaultScale() Scale {
+	if a.DefaultScale == 0 {
+		return ScaleCelsius
+	}
+	return a.DefaultScale
+}
@@ -0,0 +1,149 @@
+package tempconv
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"math"
+	"testing"
+)
+
+// Проверка реализации интерфейсов database/sql
+var (
+	_ sql.Scanner   = (*AnyTemperature)(nil)
+	_ driver.Valuer = AnyTemperature{}
+	_ sql.Scanner   = (*Scale)(nil)
+	_ driver.Valuer = Scale(0)
+)
+
+// TestAnyTemperatureScan проверяет восстановление конкретного типа по шкале.
+func TestAnyTemperatureScan(t *testing.T) {
+	tests := []struct {
+		src      any
+		expected Temperature
+		err      error
+	}{
+		{"25.00 C", Celsius(25), nil},
+		{[]byte("77.00 Fahrenheit"), Fahrenheit(77), nil},
+		{"0 °De", Delisle(0), nil},
+		{nil, nil, nil},
+		{"-1 K", nil, ErrBelowAbsoluteZero},
+		{"25 X", nil, ErrUnknownScale},
+		{25.0, nil, ErrInvalidSQLValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(nameOf(tt.expected), func(t *testing.T) {
+			var a AnyTemperature
+			err := a.Scan(tt.src)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if err == nil && a.Temperature != tt.expected {
+				t.Fatalf("expected %v, got %v", tt.expected, a.Temperature)
+			}
+		})
+	}
+}
+
+// nameOf возвращает имя подтеста для температуры или nil.
+func nameOf(t Temperature) string {
+	if t == nil {
+		return "nil"
+	}
+	return FormatTemperature(t)
+}
+
+// TestAnyTemperatureValue проверяет запись температуры со шкалой.
+func TestAnyTemperatureValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    AnyTemperature
+		expected driver.Value
+		err      error
+	}{
+		{"Celsius", AnyTemperature{Temperature: Celsius(21.5)}, "21.5 C", nil},
+		{"Newton", AnyTemperature{Temperature: Newton(33)}, "33 N", nil},
+		{"NULL", AnyTemperature{}, nil, nil},
+		{"below absolute zero", AnyTemperature{Temperature: Kelvin(-1)}, nil, ErrBelowAbsoluteZero},
+		{"NaN", AnyTemperature{Temperature: Celsius(math.NaN())}, nil, ErrInvalidSQLValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := tt.input.Value()
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if v != tt.expected {
+				t.Fatalf("expected %v, got %v", tt.expected, v)
+			}
+		})
+	}
+}
+
+// TestAnyTemperatureColumns проверяет хранение температуры в двух столбцах.
+func TestAnyTemperatureColumns(t *testing.T) {
+	a, err := NewAnyTemperature(98.6, ScaleFahrenheit)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	v, s := a.Columns()
+	sv, err := s.Value()
+	if err != nil || v != 98.6 || sv != "Fahrenheit" {
+		t.Fatalf("Columns() = %v, %v (%v); want 98.6, Fahrenheit", v, sv, err)
+	}
+
+	var scale Scale
+	if err := scale.Scan(sv); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	back, err := NewAnyTemperature(v, scale)
+	if err != nil || back != a {
+		t.Fatalf("NewAnyTemperature() = %v, %v; want %v", back, err, a)
+	}
+
+	if _, err := NewAnyTemperature(-500, ScaleFahrenheit); !errors.Is(err, ErrBelowAbsoluteZero) {
+		t.Errorf("expected error %v, got %v", ErrBelowAbsoluteZero, err)
+	}
+	if _, err := Scale(0).Value(); !errors.Is(err, ErrUnknownScale) {
+		t.Errorf("expected error %v, got %v", ErrUnknownScale, err)
+	}
+	if v, s := (AnyTemperature{}).Columns(); !math.IsNaN(v) || s != 0 {
+		t.Errorf("Columns() of NULL = %v, %v; want NaN, 0", v, s)
+	}
+}
+
+// TestAnyTemperatureString проверяет строковое представление.
+func TestAnyTemperatureString(t *testing.T) {
+	if s := (AnyTemperature{Temperature: Kelvin(300)}).String(); s != "300.00K" {
+		t.Errorf("String() = %q, want %q", s, "300.00K")
+	}
+	if s := (AnyTemperature{}).String(); s != "<nil>" {
+		t.Errorf("String() = %q, want %q", s, "<nil>")
+	}
+}
+
+// TestAnyTemperatureNull проверяет, что значение, прочитанное из NULL, можно
+// использовать без паники.
+func TestAnyTemperatureNull(t *testing.T) {
+	a := AnyTemperature{Temperature: Celsius(20)}
+	if err := a.Scan(nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.Valid() {
+		t.Fatalf("Valid() = true after NULL")
+	}
+	if s := a.String(); s != "<nil>" {
+		t.Errorf("String() = %q, want %q", s, "<nil>")
+	}
+	if v, s := a.Columns(); !math.IsNaN(v) || s != 0 {
+		t.Errorf("Columns() = %v, %v; want NaN, 0", v, s)
+	}
+	if v, err := a.Value(); v != nil || err != nil {
+		t.Errorf("Value() = %v, %v; want nil, nil", v, err)
+	}
+	if text, err := a.MarshalText(); len(text) != 0 || err != nil {
+		t.Errorf("MarshalText() = %q, %v; want empty", text, err)
+	}
+}
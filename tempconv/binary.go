@@ -0,0 +1,169 @@
+package tempconv
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// Encoding - способ кодирования числового значения температуры в двоичном
+// представлении и в CBOR.
+type Encoding byte
+
+// Способы кодирования значения
+const (
+	// EncodingFloat64 - число двойной точности (8 байт), без потерь
+	EncodingFloat64 Encoding = iota
+	// EncodingFloat32 - число одинарной точности (4 байта)
+	EncodingFloat32
+	// EncodingFixed - фиксированная точка с шагом 0.1 градуса: в двоичном
+	// представлении int16 (2 байта, от -3276.8 до 3276.7), в CBOR -
+	// десятичная дробь (тег 4)
+	EncodingFixed
+)
+
+// String возвращает название способа кодирования.
+func (e Encoding) String() string {
+	switch e {
+	case EncodingFloat64:
+		return "float64"
+	case EncodingFloat32:
+		return "float32"
+	case EncodingFixed:
+		return "fixed"
+	}
+	return fmt.Sprintf("Encoding(%d)", int(e))
+}
+
+// Двоичное представление температуры: первый байт содержит способ
+// кодирования в старших четырех битах и шкалу в младших, за ним следует
+// значение в порядке байтов big-endian:
+//
+//	EncodingFloat64: 1 + 8 байт
+//	EncodingFloat32: 1 + 4 байта
+//	EncodingFixed:   1 + 2 байта (десятые доли градуса)
+//
+// Кодирование с потерями (float32 и фиксированная точка) округляет значение
+// в сторону от абсолютного нуля, если обычное округление вывело бы его за
+// абсолютный ноль, поэтому закодированная температура всегда проходит
+// проверку при разборе.
+
+// AppendBinary добавляет к dst двоичное представление температуры t в ее
+// собственной шкале со способом кодирования enc.
+func AppendBinary(dst []byte, t Temperature, enc Encoding) ([]byte, error) {
+	s, v, err := encodable(t)
+	if err != nil {
+		return nil, err
+	}
+	header := byte(enc)<<4 | byte(s)
+	switch enc {
+	case EncodingFloat64:
+		dst = append(dst, header)
+		return binary.BigEndian.AppendUint64(dst, math.Float64bits(v)), nil
+	case EncodingFloat32:
+		dst = append(dst, header)
+		return binary.BigEndian.AppendUint32(dst, math.Float32bits(toFloat32(s, v))), nil
+	case EncodingFixed:
+		m, err := toFixed(s, v)
+		if err != nil {
+			return nil, err
+		}
+		if m < math.MinInt16 || m > math.MaxInt16 {
+			return nil, fmt.Errorf("%w: %v в %s", ErrEncodingRange, t, enc)
+		}
+		dst = append(dst, header)
+		return binary.BigEndian.AppendUint16(dst, uint16(int16(m))), nil
+	}
+	return nil, fmt.Errorf("%w: %v", ErrInvalidBinary, enc)
+}
+
+// DecodeBinary разбирает двоичное представление температуры, созданное
+// AppendBinary или MarshalBinary. Температура создается с проверкой
+// абсолютного нуля в шкале, указанной в представлении.
+func DecodeBinary(data []byte) (Temperature, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w: нет данных", ErrInvalidBinary)
+	}
+	enc, s := Encoding(data[0]>>4), Scale(data[0]&0x0f)
+	if !s.Valid() {
+		return nil, fmt.Errorf("%w: %w: %d", ErrInvalidBinary, ErrUnknownScale, int(s))
+	}
+	payload := data[1:]
+
+	var v float64
+	switch {
+	case enc == EncodingFloat64 && len(payload) == 8:
+		v = math.Float64frombits(binary.BigEndian.Uint64(payload))
+	case enc == EncodingFloat32 && len(payload) == 4:
+		v = float64(math.Float32frombits(binary.BigEndian.Uint32(payload)))
+	case enc == EncodingFixed && len(payload) == 2:
+		v = float64(int16(binary.BigEndian.Uint16(payload))) / fixedScale
+	default:
+		return nil, fmt.Errorf("%w: кодирование %v, %d байт", ErrInvalidBinary, enc, len(payload))
+	}
+	return decoded(s, v)
+}
+
+// fixedScale - число единиц фиксированной точки в одном градусе
+const fixedScale = 10
+
+// encodable проверяет, что температуру t можно закодировать, и возвращает ее
+// шкалу и значение.
+func encodable(t Temperature) (Scale, float64, error) {
+	if t == nil {
+		return 0, 0, fmt.Errorf("%w: не задана температура", ErrInvalidBinary)
+	}
+	s := ScaleOf(t)
+	if !s.Valid() {
+		return 0, 0, fmt.Errorf("%w: %s", ErrUnknownScale, t.ScaleName())
+	}
+	v := ValueOf(t)
+	if math.IsNaN(v) {
+		return 0, 0, fmt.Errorf("%w: NaN", ErrInvalidBinary)
+	}
+	if _, err := s.New(v); err != nil {
+		return 0, 0, err
+	}
+	return s, v, nil
+}
+
+// decoded создает разобранную температуру с проверкой абсолютного нуля.
+func decoded(s Scale, v float64) (Temperature, error) {
+	if math.IsNaN(v) {
+		return nil, fmt.Errorf("%w: NaN", ErrInvalidBinary)
+	}
+	return s.New(v)
+}
+
+// toFloat32 округляет v до float32, не переходя абсолютный ноль шкалы s.
+func toFloat32(s Scale, v float64) float32 {
+	f := float32(v)
+	if _, err := s.New(float64(f)); err != nil {
+		f = math.Nextafter32(f, float32(awayFromAbsoluteZero(s)))
+	}
+	return f
+}
+
+// toFixed переводит v в десятые доли градуса, не переходя абсолютный ноль
+// шкалы s.
+func toFixed(s Scale, v float64) (int64, error) {
+	r := math.Round(v * fixedScale)
+	if math.IsInf(r, 0) || math.Abs(r) > math.MaxInt64/2 {
+		return 0, fmt.Errorf("%w: %v", ErrEncodingRange, v)
+	}
+	m := int64(r)
+	if _, err := s.New(float64(m) / fixedScale); err != nil {
+		m += int64(math.Copysign(1, awayFromAbsoluteZero(s)))
+	}
+	return m, nil
+}
+
+// awayFromAbsoluteZero возвращает бесконечность в направлении от
+// абсолютного нуля шкалы s: +Inf для всех шкал, кроме обратной шкалы
+// Делисля.
+func awayFromAbsoluteZero(s Scale) float64 {
+	if s == ScaleDelisle {
+		return math.Inf(-1)
+	}
+	return math.Inf(1)
+}
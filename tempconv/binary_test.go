@@ -0,0 +1,175 @@
+package tempconv
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// Проверка реализации интерфейсов encoding
+var (
+	_ encoding.BinaryMarshaler   = Celsius(0)
+	_ encoding.BinaryUnmarshaler = (*Celsius)(nil)
+	_ encoding.BinaryMarshaler   = Delisle(0)
+	_ encoding.BinaryUnmarshaler = (*Delisle)(nil)
+)
+
+// TestAppendBinary проверяет двоичное представление для всех способов
+// кодирования.
+func TestAppendBinary(t *testing.T) {
+	tests := []struct {
+		input    Temperature
+		enc      Encoding
+		expected []byte
+	}{
+		{Celsius(21.5), EncodingFloat64, []byte{0x01, 0x40, 0x35, 0x80, 0, 0, 0, 0, 0}},
+		{Kelvin(300), EncodingFloat32, []byte{0x13, 0x43, 0x96, 0, 0}},
+		{Celsius(21.5), EncodingFixed, []byte{0x21, 0x00, 0xd7}},
+		{Fahrenheit(-40), EncodingFixed, []byte{0x22, 0xfe, 0x70}},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v %v", tt.input, tt.enc), func(t *testing.T) {
+			got, err := AppendBinary(nil, tt.input, tt.enc)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !bytes.Equal(got, tt.expected) {
+				t.Fatalf("expected % x, got % x", tt.expected, got)
+			}
+			back, err := DecodeBinary(got)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if back != tt.input {
+				t.Fatalf("expected %v, got %v", tt.input, back)
+			}
+		})
+	}
+}
+
+// TestLossyEncodingAtAbsoluteZero проверяет, что округление при кодировании с
+// потерями не выводит значение за абсолютный ноль.
+func TestLossyEncodingAtAbsoluteZero(t *testing.T) {
+	for _, s := range Scales() {
+		for _, enc := range []Encoding{EncodingFloat32, EncodingFixed} {
+			t.Run(fmt.Sprintf("%v %v", s, enc), func(t *testing.T) {
+				data, err := AppendBinary(nil, s.AbsoluteZero(), enc)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				got, err := DecodeBinary(data)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				// Погрешность не превышает шага фиксированной точки (0.1 градуса шкалы).
+				step := math.Abs(Delta{Value: 0.1, Scale: s}.Kelvins())
+				if k := float64(got.ToKelvin()); k < 0 || k > step {
+					t.Fatalf("expected 0..%v K, got %v", step, got.ToKelvin())
+				}
+			})
+		}
+	}
+}
+
+// TestBinaryErrors проверяет ошибки кодирования и разбора.
+func TestBinaryErrors(t *testing.T) {
+	encodeTests := []struct {
+		name  string
+		input Temperature
+		enc   Encoding
+		err   error
+	}{
+		{"below absolute zero", Kelvin(-1), EncodingFloat64, ErrBelowAbsoluteZero},
+		{"NaN", Celsius(math.NaN()), EncodingFloat32, ErrInvalidBinary},
+		{"fixed out of range", Kelvin(5000), EncodingFixed, ErrEncodingRange},
+		{"unknown encoding", Celsius(1), Encoding(9), ErrInvalidBinary},
+		{"nil", nil, EncodingFloat64, ErrInvalidBinary},
+	}
+	for _, tt := range encodeTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := AppendBinary(nil, tt.input, tt.enc); !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+		})
+	}
+
+	decodeTests := []struct {
+		name  string
+		input []byte
+		err   error
+	}{
+		{"empty", nil, ErrInvalidBinary},
+		{"unknown scale", []byte{0x08, 0, 0, 0, 0, 0, 0, 0, 0}, ErrUnknownScale},
+		{"short payload", []byte{0x01, 0x40}, ErrInvalidBinary},
+		{"below absolute zero", []byte{0x23, 0xff, 0xff}, ErrBelowAbsoluteZero},
+	}
+	for _, tt := range decodeTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeBinary(tt.input); !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+// TestMarshalBinary проверяет, что разбор преобразует температуру в шкалу
+// получателя.
+func TestMarshalBinary(t *testing.T) {
+	data, err := Kelvin(373.15).MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var c Celsius
+	if err := c.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !almostEqual(float64(c), 100, 1e-9) {
+		t.Fatalf("expected 100°C, got %v", c)
+	}
+
+	var k Kelvin
+	if err := k.UnmarshalBinary([]byte{0x03, 0xbf, 0xf0, 0, 0, 0, 0, 0, 0}); !errors.Is(err, ErrBelowAbsoluteZero) {
+		t.Fatalf("expected error %v, got %v", ErrBelowAbsoluteZero, err)
+	}
+}
+
+// TestGob проверяет запись температур через encoding/gob: значение
+// записывается в представлении MarshalBinary, сохраняет шкалу при разборе в
+// другой тип, а поток со значением float64 в тип температуры не разбирается.
+func TestGob(t *testing.T) {
+	type reading struct {
+		Value Kelvin
+	}
+	type celsiusReading struct {
+		Value Celsius
+	}
+
+	var b bytes.Buffer
+	if err := gob.NewEncoder(&b).Encode(reading{Value: 373.15}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var r celsiusReading
+	if err := gob.NewDecoder(&b).Decode(&r); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !almostEqual(float64(r.Value), 100, 1e-9) {
+		t.Errorf("decoded %v, want 100°C", r.Value)
+	}
+
+	// Потоки, записанные до появления MarshalBinary, несовместимы
+	type oldReading struct {
+		Value float64
+	}
+	b.Reset()
+	if err := gob.NewEncoder(&b).Encode(oldReading{Value: 21.5}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := gob.NewDecoder(&b).Decode(&r); err == nil {
+		t.Errorf("decoding float64 gob stream into Celsius succeeded, want error")
+	}
+}
@@ -0,0 +1,257 @@
+package tempconv
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// Представление температуры в CBOR (RFC 8949) - массив из двух элементов:
+// номер шкалы (целое без знака, как в Scale) и значение. Значение кодируется
+// числом с плавающей точкой (EncodingFloat64, EncodingFloat32) или
+// десятичной дробью с показателем -1 (тег 4, EncodingFixed):
+//
+//	[1, 21.5]            -> 82 01 FA 41 AC 00 00
+//	[1, 4([-1, 215])]    -> 82 01 C4 82 20 18 D7
+//
+// При разборе также допускаются целые значения, числа половинной точности и
+// десятичные дроби с любым показателем.
+
+// Основные типы CBOR
+const (
+	cborUint   = 0
+	cborNegint = 1
+	cborArray  = 4
+	cborTag    = 6
+	cborSimple = 7
+
+	// cborDecimalFraction - тег десятичной дроби
+	cborDecimalFraction = 4
+)
+
+// AppendCBOR добавляет к dst представление температуры t в CBOR в ее
+// собственной шкале со способом кодирования enc.
+func AppendCBOR(dst []byte, t Temperature, enc Encoding) ([]byte, error) {
+	s, v, err := encodable(t)
+	if err != nil {
+		return nil, err
+	}
+	switch enc {
+	case EncodingFloat64:
+		dst = appendCBORHead(dst, cborArray, 2)
+		dst = appendCBORHead(dst, cborUint, uint64(s))
+		dst = append(dst, cborSimple<<5|27)
+		return binary.BigEndian.AppendUint64(dst, math.Float64bits(v)), nil
+	case EncodingFloat32:
+		dst = appendCBORHead(dst, cborArray, 2)
+		dst = appendCBORHead(dst, cborUint, uint64(s))
+		dst = append(dst, cborSimple<<5|26)
+		return binary.BigEndian.AppendUint32(dst, math.Float32bits(toFloat32(s, v))), nil
+	case EncodingFixed:
+		m, err := toFixed(s, v)
+		if err != nil {
+			return nil, err
+		}
+		dst = appendCBORHead(dst, cborArray, 2)
+		dst = appendCBORHead(dst, cborUint, uint64(s))
+		dst = appendCBORHead(dst, cborTag, cborDecimalFraction)
+		dst = appendCBORHead(dst, cborArray, 2)
+		dst = appendCBORInt(dst, -1)
+		return appendCBORInt(dst, m), nil
+	}
+	return nil, fmt.Errorf("%w: %v", ErrInvalidBinary, enc)
+}
+
+// DecodeCBOR разбирает представление температуры в CBOR, созданное
+// AppendCBOR или MarshalCBOR. Температура создается с проверкой абсолютного
+// нуля в шкале, указанной в представлении.
+func DecodeCBOR(data []byte) (Temperature, error) {
+	r := cborReader{data: data}
+	if major, n, err := r.head(); err != nil || major != cborArray || n != 2 {
+		return nil, r.fail(err, "ожидается массив из двух элементов")
+	}
+	major, n, err := r.head()
+	if err != nil || major != cborUint {
+		return nil, r.fail(err, "ожидается номер шкалы")
+	}
+	s := Scale(n)
+	if n > math.MaxInt8 || !s.Valid() {
+		return nil, fmt.Errorf("%w: %w: %d", ErrInvalidBinary, ErrUnknownScale, n)
+	}
+	v, err := r.number()
+	if err != nil {
+		return nil, err
+	}
+	if r.pos != len(r.data) {
+		return nil, r.fail(nil, "лишние данные")
+	}
+	return decoded(s, v)
+}
+
+// defaultCBOR кодирует температуру t одинарной точностью, если это не
+// приводит к потере точности, и двойной точностью в противном случае.
+func defaultCBOR(t Temperature) ([]byte, error) {
+	v := ValueOf(t)
+	if float64(float32(v)) == v {
+		return AppendCBOR(nil, t, EncodingFloat32)
+	}
+	return AppendCBOR(nil, t, EncodingFloat64)
+}
+
+// appendCBORHead добавляет заголовок элемента CBOR с основным типом major и
+// аргументом n в кратчайшей форме.
+func appendCBORHead(dst []byte, major byte, n uint64) []byte {
+	switch {
+	case n < 24:
+		return append(dst, major<<5|byte(n))
+	case n <= math.MaxUint8:
+		return append(dst, major<<5|24, byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(dst, major<<5|25), uint16(n))
+	case n <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(dst, major<<5|26), uint32(n))
+	}
+	return binary.BigEndian.AppendUint64(append(dst, major<<5|27), n)
+}
+
+// appendCBORInt добавляет целое число со знаком.
+func appendCBORInt(dst []byte, n int64) []byte {
+	if n < 0 {
+		return appendCBORHead(dst, cborNegint, uint64(-1-n))
+	}
+	return appendCBORHead(dst, cborUint, uint64(n))
+}
+
+// cborReader последовательно читает элементы CBOR.
+type cborReader struct {
+	data []byte
+	pos  int
+}
+
+// fail возвращает ошибку разбора с позицией.
+func (r *cborReader) fail(err error, msg string) error {
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: CBOR, байт %d: %s", ErrInvalidBinary, r.pos, msg)
+}
+
+// next возвращает следующие n байт.
+func (r *cborReader) next(n int) ([]byte, error) {
+	if len(r.data)-r.pos < n {
+		return nil, r.fail(nil, "неожиданный конец данных")
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+// head читает заголовок элемента и возвращает основной тип и аргумент. Для
+// чисел с плавающей точкой аргумент содержит биты числа.
+func (r *cborReader) head() (byte, uint64, error) {
+	b, err := r.next(1)
+	if err != nil {
+		return 0, 0, err
+	}
+	major, info := b[0]>>5, b[0]&0x1f
+	if info < 24 {
+		return major, uint64(info), nil
+	}
+	if info > 27 {
+		return 0, 0, r.fail(nil, "неподдерживаемая длина аргумента")
+	}
+	arg, err := r.next(1 << (info - 24))
+	if err != nil {
+		return 0, 0, err
+	}
+	var n uint64
+	for _, c := range arg {
+		n = n<<8 | uint64(c)
+	}
+	return major, n, nil
+}
+
+// integer читает целое число со знаком.
+func (r *cborReader) integer() (int64, error) {
+	major, n, err := r.head()
+	if err != nil {
+		return 0, err
+	}
+	if (major != cborUint && major != cborNegint) || n > math.MaxInt64 {
+		return 0, r.fail(nil, "ожидается целое число")
+	}
+	if major == cborNegint {
+		return -1 - int64(n), nil
+	}
+	return int64(n), nil
+}
+
+// number читает значение температуры: целое число, число с плавающей точкой
+// или десятичную дробь.
+func (r *cborReader) number() (float64, error) {
+	start := r.pos
+	major, n, err := r.head()
+	if err != nil {
+		return 0, err
+	}
+	switch major {
+	case cborUint, cborNegint:
+		r.pos = start
+		i, err := r.integer()
+		return float64(i), err
+	case cborSimple:
+		switch r.data[start] & 0x1f {
+		case 25:
+			return halfToFloat64(uint16(n)), nil
+		case 26:
+			return float64(math.Float32frombits(uint32(n))), nil
+		case 27:
+			return math.Float64frombits(n), nil
+		}
+	case cborTag:
+		if n != cborDecimalFraction {
+			break
+		}
+		if major, n, err := r.head(); err != nil || major != cborArray || n != 2 {
+			return 0, r.fail(err, "ожидается десятичная дробь")
+		}
+		exp, err := r.integer()
+		if err != nil {
+			return 0, err
+		}
+		mant, err := r.integer()
+		if err != nil {
+			return 0, err
+		}
+		if exp < -300 || exp > 300 {
+			return 0, r.fail(nil, "недопустимый показатель десятичной дроби")
+		}
+		if exp < 0 {
+			return float64(mant) / math.Pow10(int(-exp)), nil
+		}
+		return float64(mant) * math.Pow10(int(exp)), nil
+	}
+	return 0, fmt.Errorf("%w: CBOR, байт %d: ожидается число", ErrInvalidBinary, start)
+}
+
+// halfToFloat64 преобразует число половинной точности (IEEE 754 binary16).
+func halfToFloat64(h uint16) float64 {
+	exp, mant := int(h>>10&0x1f), float64(h&0x3ff)
+	var v float64
+	switch exp {
+	case 0:
+		v = math.Ldexp(mant, -24)
+	case 0x1f:
+		if mant == 0 {
+			v = math.Inf(1)
+		} else {
+			v = math.NaN()
+		}
+	default:
+		v = math.Ldexp(mant+1024, exp-25)
+	}
+	if h&0x8000 != 0 {
+		v = -v
+	}
+	return v
+}
@@ -0,0 +1,94 @@
+package tempconv
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// TestAppendCBOR проверяет представление в CBOR для всех способов
+// кодирования.
+func TestAppendCBOR(t *testing.T) {
+	tests := []struct {
+		input    Temperature
+		enc      Encoding
+		expected []byte
+	}{
+		{Celsius(21.5), EncodingFloat32, []byte{0x82, 0x01, 0xfa, 0x41, 0xac, 0, 0}},
+		{Celsius(21.5), EncodingFloat64, []byte{0x82, 0x01, 0xfb, 0x40, 0x35, 0x80, 0, 0, 0, 0, 0}},
+		{Celsius(21.5), EncodingFixed, []byte{0x82, 0x01, 0xc4, 0x82, 0x20, 0x18, 0xd7}},
+		{Fahrenheit(-40), EncodingFixed, []byte{0x82, 0x02, 0xc4, 0x82, 0x20, 0x39, 0x01, 0x8f}},
+		{Kelvin(2), EncodingFixed, []byte{0x82, 0x03, 0xc4, 0x82, 0x20, 0x14}},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v %v", tt.input, tt.enc), func(t *testing.T) {
+			got, err := AppendCBOR(nil, tt.input, tt.enc)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !bytes.Equal(got, tt.expected) {
+				t.Fatalf("expected % x, got % x", tt.expected, got)
+			}
+			back, err := DecodeCBOR(got)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if back != tt.input {
+				t.Fatalf("expected %v, got %v", tt.input, back)
+			}
+		})
+	}
+}
+
+// TestDecodeCBOR проверяет разбор представлений других кодировщиков.
+func TestDecodeCBOR(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected Temperature
+		err      error
+	}{
+		{"integer", []byte{0x82, 0x03, 0x19, 0x01, 0x2c}, Kelvin(300), nil},
+		{"negative integer", []byte{0x82, 0x01, 0x38, 0x27}, Celsius(-40), nil},
+		{"half float", []byte{0x82, 0x05, 0xf9, 0x3e, 0x00}, Reaumur(1.5), nil},
+		{"decimal fraction", []byte{0x82, 0x06, 0xc4, 0x82, 0x21, 0x19, 0x30, 0x39}, Delisle(123.45), nil},
+		{"not an array", []byte{0x01}, nil, ErrInvalidBinary},
+		{"unknown scale", []byte{0x82, 0x09, 0x00}, nil, ErrUnknownScale},
+		{"string value", []byte{0x82, 0x01, 0x61, 0x31}, nil, ErrInvalidBinary},
+		{"trailing data", []byte{0x82, 0x01, 0x00, 0x00}, nil, ErrInvalidBinary},
+		{"truncated", []byte{0x82, 0x01, 0xfb, 0x40}, nil, ErrInvalidBinary},
+		{"below absolute zero", []byte{0x82, 0x03, 0x20}, nil, ErrBelowAbsoluteZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeCBOR(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if err == nil && got != tt.expected {
+				t.Fatalf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestMarshalCBOR проверяет выбор кратчайшего кодирования без потерь и
+// преобразование в шкалу получателя.
+func TestMarshalCBOR(t *testing.T) {
+	short, _ := Celsius(21.5).MarshalCBOR()
+	long, _ := Celsius(21.1).MarshalCBOR()
+	if len(short) != 7 || len(long) != 11 {
+		t.Fatalf("expected 7 and 11 bytes, got %d and %d", len(short), len(long))
+	}
+
+	var f Fahrenheit
+	if err := f.UnmarshalCBOR(short); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !almostEqual(float64(f), 70.7, 1e-9) {
+		t.Fatalf("expected 70.70°F, got %v", f)
+	}
+}
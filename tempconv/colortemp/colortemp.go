@@ -0,0 +1,196 @@
+package colortemp
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// Ошибки преобразований цветовой температуры
+var (
+	ErrOutOfRange          = errors.New("цветовая температура вне области применимости")
+	ErrInvalidMired        = errors.New("недопустимое значение в майредах")
+	ErrInvalidChromaticity = errors.New("недопустимые координаты цветности")
+)
+
+// Границы аппроксимации локуса Планка Kang et al.
+const (
+	// MinCCT - минимальная цветовая температура аппроксимации, К
+	MinCCT tempconv.Kelvin = 1667
+	// MaxCCT - максимальная цветовая температура аппроксимации, К
+	MaxCCT tempconv.Kelvin = 25000
+)
+
+// miredScale - коэффициент пересчета кельвинов в майреды
+const miredScale = 1e6
+
+// XY - координаты цветности CIE 1931.
+type XY struct {
+	X, Y float64
+}
+
+// RGB - цвет в пространстве sRGB с 8-битными компонентами.
+type RGB struct {
+	R, G, B uint8
+}
+
+// Mired возвращает цветовую температуру k в майредах (10⁶/K).
+func Mired(k tempconv.Kelvin) (float64, error) {
+	if !(k > 0) {
+		return 0, fmt.Errorf("%w: %v", ErrOutOfRange, k)
+	}
+	return miredScale / float64(k), nil
+}
+
+// FromMired возвращает цветовую температуру в кельвинах для значения m в
+// майредах.
+func FromMired(m float64) (tempconv.Kelvin, error) {
+	if !(m > 0) || math.IsInf(m, 1) {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidMired, m)
+	}
+	return tempconv.NewKelvin(miredScale / m)
+}
+
+// Chromaticity возвращает координаты цветности CIE 1931 xy точки локуса Планка
+// с температурой k по кубической аппроксимации Kang et al. (2002). Температура
+// должна лежать в диапазоне [MinCCT, MaxCCT].
+func Chromaticity(k tempconv.Kelvin) (XY, error) {
+	if k < MinCCT || k > MaxCCT {
+		return XY{}, fmt.Errorf("%w: %v не в [%v, %v]", ErrOutOfRange, k, MinCCT, MaxCCT)
+	}
+	t := float64(k)
+	t2, t3 := t*t, t*t*t
+
+	var x float64
+	if t <= 4000 {
+		x = -0.2661239e9/t3 - 0.2343589e6/t2 + 0.8776956e3/t + 0.179910
+	} else {
+		x = -3.0258469e9/t3 + 2.1070379e6/t2 + 0.2226347e3/t + 0.240390
+	}
+
+	x2, x3 := x*x, x*x*x
+	var y float64
+	switch {
+	case t <= 2222:
+		y = -1.1063814*x3 - 1.34811020*x2 + 2.18555832*x - 0.20219683
+	case t <= 4000:
+		y = -0.9549476*x3 - 1.37418593*x2 + 2.09137015*x - 0.16748867
+	default:
+		y = 3.0817580*x3 - 5.87338670*x2 + 3.75112997*x - 0.37001483
+	}
+	return XY{X: x, Y: y}, nil
+}
+
+// McCamy вычисляет коррелированную цветовую температуру по координатам xy
+// кубической формулой МакКами (1992). Погрешность не превышает 2 К в
+// диапазоне 2856-6504 К.
+func McCamy(c XY) (tempconv.Kelvin, error) {
+	if err := c.validate(); err != nil {
+		return 0, err
+	}
+	n := (c.X - 0.3320) / (0.1858 - c.Y)
+	return tempconv.NewKelvin(449*n*n*n + 3525*n*n + 6823.3*n + 5520.33)
+}
+
+// robertsonIsotherm - изотерма таблицы Робертсона в координатах CIE 1960 uv.
+type robertsonIsotherm struct {
+	mired, u, v, slope float64
+}
+
+// robertsonTable - изотермы Робертсона (1968) от бесконечности до 1667 К.
+var robertsonTable = [...]robertsonIsotherm{
+	{0, 0.18006, 0.26352, -0.24341},
+	{10, 0.18066, 0.26589, -0.25479},
+	{20, 0.18133, 0.26846, -0.26876},
+	{30, 0.18208, 0.27119, -0.28539},
+	{40, 0.18293, 0.27407, -0.30470},
+	{50, 0.18388, 0.27709, -0.32675},
+	{60, 0.18494, 0.28021, -0.35156},
+	{70, 0.18611, 0.28342, -0.37915},
+	{80, 0.18740, 0.28668, -0.40955},
+	{90, 0.18880, 0.28997, -0.44278},
+	{100, 0.19032, 0.29326, -0.47888},
+	{125, 0.19462, 0.30141, -0.58204},
+	{150, 0.19962, 0.30921, -0.70471},
+	{175, 0.20525, 0.31647, -0.84901},
+	{200, 0.21142, 0.32312, -1.0182},
+	{225, 0.21807, 0.32909, -1.2168},
+	{250, 0.22511, 0.33439, -1.4512},
+	{275, 0.23247, 0.33904, -1.7298},
+	{300, 0.24010, 0.34308, -2.0637},
+	{325, 0.24792, 0.34655, -2.4681},
+	{350, 0.25591, 0.34951, -2.9641},
+	{375, 0.26400, 0.35200, -3.5814},
+	{400, 0.27218, 0.35407, -4.3633},
+	{425, 0.28039, 0.35577, -5.3762},
+	{450, 0.28863, 0.35714, -6.7262},
+	{475, 0.29685, 0.35823, -8.5955},
+	{500, 0.30505, 0.35907, -11.324},
+	{525, 0.31320, 0.35968, -15.628},
+	{550, 0.32129, 0.36011, -23.325},
+	{575, 0.32931, 0.36038, -40.770},
+	{600, 0.33724, 0.36051, -116.45},
+}
+
+// Robertson вычисляет коррелированную цветовую температуру по координатам xy
+// методом Робертсона: интерполяцией между изотермами в пространстве CIE 1960 uv.
+func Robertson(c XY) (tempconv.Kelvin, error) {
+	if err := c.validate(); err != nil {
+		return 0, err
+	}
+	d := -2*c.X + 12*c.Y + 3
+	u, v := 4*c.X/d, 6*c.Y/d
+
+	var prev float64
+	for i, iso := range robertsonTable {
+		dist := (v - iso.v) - iso.slope*(u-iso.u)
+		if i > 0 && (dist < 0) != (prev < 0) {
+			last := robertsonTable[i-1]
+			dPrev := prev / math.Sqrt(1+last.slope*last.slope)
+			dCur := dist / math.Sqrt(1+iso.slope*iso.slope)
+			p := dPrev / (dPrev - dCur)
+			return tempconv.NewKelvin(miredScale / (last.mired + p*(iso.mired-last.mired)))
+		}
+		prev = dist
+	}
+	return 0, fmt.Errorf("%w: x=%.4f y=%.4f", ErrOutOfRange, c.X, c.Y)
+}
+
+// SRGB возвращает приближенный цвет излучателя с температурой k в sRGB (D65).
+// Цвет нормирован так, чтобы наибольшая компонента была равна 255.
+func SRGB(k tempconv.Kelvin) (RGB, error) {
+	c, err := Chromaticity(k)
+	if err != nil {
+		return RGB{}, err
+	}
+	// Переход к XYZ с Y = 1 и линейным компонентам sRGB
+	x, y, z := c.X/c.Y, 1.0, (1-c.X-c.Y)/c.Y
+	r := 3.2404542*x - 1.5371385*y - 0.4985314*z
+	g := -0.9692660*x + 1.8760108*y + 0.0415560*z
+	b := 0.0556434*x - 0.2040259*y + 1.0572252*z
+
+	r, g, b = math.Max(r, 0), math.Max(g, 0), math.Max(b, 0)
+	m := math.Max(r, math.Max(g, b))
+	return RGB{R: encodeSRGB(r / m), G: encodeSRGB(g / m), B: encodeSRGB(b / m)}, nil
+}
+
+// encodeSRGB применяет гамма-кривую sRGB к линейной компоненте из [0, 1].
+func encodeSRGB(c float64) uint8 {
+	if c <= 0.0031308 {
+		c *= 12.92
+	} else {
+		c = 1.055*math.Pow(c, 1/2.4) - 0.055
+	}
+	return uint8(math.Round(255 * c))
+}
+
+// validate проверяет, что координаты лежат внутри треугольника x, y > 0,
+// x + y <= 1.
+func (c XY) validate() error {
+	if !(c.X > 0 && c.Y > 0 && c.X+c.Y <= 1) {
+		return fmt.Errorf("%w: x=%v y=%v", ErrInvalidChromaticity, c.X, c.Y)
+	}
+	return nil
+}
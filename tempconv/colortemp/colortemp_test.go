@@ -0,0 +1,144 @@
+package colortemp
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// almostEqual проверяет, что два числа почти равны с заданной погрешностью.
+func almostEqual(a, b float64, epsilon float64) bool {
+	return math.Abs(a-b) <= epsilon
+}
+
+// TestMired проверяет преобразование в майреды и обратно.
+func TestMired(t *testing.T) {
+	tests := []struct {
+		kelvin tempconv.Kelvin
+		mired  float64
+	}{
+		{2000, 500},
+		{2700, 370.37},
+		{6500, 153.85},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("Mired %v", tt.kelvin), func(t *testing.T) {
+			m, err := Mired(tt.kelvin)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !almostEqual(m, tt.mired, 0.01) {
+				t.Errorf("Mired() = %v, want %v", m, tt.mired)
+			}
+			k, err := FromMired(m)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !almostEqual(float64(k), float64(tt.kelvin), 1e-9) {
+				t.Errorf("FromMired() = %v, want %v", k, tt.kelvin)
+			}
+		})
+	}
+
+	if _, err := Mired(0); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("expected error %v, got %v", ErrOutOfRange, err)
+	}
+	if _, err := FromMired(-1); !errors.Is(err, ErrInvalidMired) {
+		t.Errorf("expected error %v, got %v", ErrInvalidMired, err)
+	}
+}
+
+// TestChromaticityRoundTrip проверяет, что McCamy и Robertson восстанавливают
+// температуру точки локуса Планка.
+func TestChromaticityRoundTrip(t *testing.T) {
+	tests := []struct {
+		kelvin    tempconv.Kelvin
+		mccamyTol float64
+	}{
+		{2000, 25},
+		{2856, 10},
+		{4000, 10},
+		{5000, 10},
+		{6504, 2},
+		{10000, 150},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("CCT %v", tt.kelvin), func(t *testing.T) {
+			xy, err := Chromaticity(tt.kelvin)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			mc, err := McCamy(xy)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !almostEqual(float64(mc), float64(tt.kelvin), tt.mccamyTol) {
+				t.Errorf("McCamy() = %v, want %v", mc, tt.kelvin)
+			}
+			rb, err := Robertson(xy)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !almostEqual(float64(rb), float64(tt.kelvin), 0.01*float64(tt.kelvin)) {
+				t.Errorf("Robertson() = %v, want %v", rb, tt.kelvin)
+			}
+		})
+	}
+}
+
+// TestRobertsonD65 проверяет CCT белой точки D65.
+func TestRobertsonD65(t *testing.T) {
+	k, err := Robertson(XY{X: 0.3127, Y: 0.3290})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !almostEqual(float64(k), 6504, 1) {
+		t.Errorf("Robertson(D65) = %v, want 6504K", k)
+	}
+}
+
+// TestSRGB проверяет приближенный цвет излучателя.
+func TestSRGB(t *testing.T) {
+	tests := []struct {
+		kelvin   tempconv.Kelvin
+		expected RGB
+	}{
+		{1900, RGB{255, 132, 0}},
+		{2700, RGB{255, 173, 89}},
+		{6504, RGB{255, 249, 254}},
+		{10000, RGB{205, 217, 255}},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("SRGB %v", tt.kelvin), func(t *testing.T) {
+			got, err := SRGB(tt.kelvin)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("SRGB() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestErrors проверяет обработку значений вне области применимости.
+func TestErrors(t *testing.T) {
+	if _, err := Chromaticity(1000); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("expected error %v, got %v", ErrOutOfRange, err)
+	}
+	if _, err := SRGB(30000); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("expected error %v, got %v", ErrOutOfRange, err)
+	}
+	if _, err := McCamy(XY{X: 0.8, Y: 0.5}); !errors.Is(err, ErrInvalidChromaticity) {
+		t.Errorf("expected error %v, got %v", ErrInvalidChromaticity, err)
+	}
+	if _, err := Robertson(XY{X: 0.7, Y: 0.25}); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("expected error %v, got %v", ErrOutOfRange, err)
+	}
+}
@@ -0,0 +1,23 @@
+// Пакет colortemp содержит преобразования цветовой температуры источников
+// света, заданной типом tempconv.Kelvin.
+//
+// # Возможности:
+//
+// - Mired, FromMired — преобразование в обратные мегакельвины (майреды), в которых
+// работают кластеры управления цветом Zigbee и Matter,
+//
+// - Chromaticity    — координаты цветности CIE 1931 xy на локусе Планка (Kang et al., 2002),
+//
+// - McCamy, Robertson — коррелированная цветовая температура (CCT) по координатам xy,
+//
+// - SRGB            — приближенный цвет источника в пространстве sRGB.
+//
+// # Пример использования:
+//
+//	m, err := colortemp.Mired(tempconv.Kelvin(2700))
+//	if err != nil {
+//	    fmt.Println("Ошибка:", err)
+//	    return
+//	}
+//	fmt.Printf("%.0f mired\n", m) // 370 mired
+package colortemp
@@ -0,0 +1,30 @@
+// Пакет control содержит ПИД-регулятор температуры.
+//
+// Уставка и измеряемая величина регулятора задаются значениями
+// tempconv.Temperature в любых шкалах; рассогласование вычисляется как
+// разность температур (tempconv.Delta) в шкале, указанной в настройках, и
+// коэффициенты регулятора относятся к градусу этой шкалы. Поэтому уставка
+// 200°C и показание 390°F дают рассогласование Δ≈1.11°C, а не 190 единиц.
+// Уставка и показание могут быть заданы в шкале Делиля, но шкалой настроек
+// она быть не может: ее градус отрицателен, и рассогласование меняло бы знак.
+//
+// Регулятор поддерживает:
+//   - ограничение выхода и защиту интегратора от насыщения;
+//   - дифференцирование по измеряемой величине с фильтром первого порядка;
+//   - безударное изменение уставки и коэффициентов;
+//   - детерминированный шаг Step с явным интервалом времени, что позволяет
+//     использовать регулятор в моделировании.
+//
+// # Пример использования:
+//
+//	pid, err := control.New(control.Config{
+//	    Kp: 2, Ki: 0.1, Kd: 5,
+//	    Scale:     tempconv.ScaleCelsius,
+//	    OutputMin: 0, OutputMax: 100,
+//	}, tempconv.Celsius(180))
+//	if err != nil {
+//	    fmt.Println("Ошибка:", err)
+//	    return
+//	}
+//	power, _ := pid.Step(tempconv.Fahrenheit(300), time.Second)
+package control
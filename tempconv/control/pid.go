@@ -0,0 +1,190 @@
+package control
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// Ошибки регулятора
+var (
+	ErrInvalidConfig  = errors.New("недопустимые настройки регулятора")
+	ErrInvalidStep    = errors.New("недопустимый шаг по времени")
+	ErrNilTemperature = errors.New("не задана температура")
+)
+
+// Config - настройки ПИД-регулятора.
+type Config struct {
+	// Kp, Ki, Kd - пропорциональный, интегральный (1/с) и дифференциальный (с)
+	// коэффициенты на градус шкалы Scale
+	Kp, Ki, Kd float64
+	// Scale - шкала, в которой вычисляется рассогласование; обратная шкала
+	// Делиля не допускается
+	Scale tempconv.Scale
+	// OutputMin, OutputMax - пределы выхода регулятора; допускаются
+	// бесконечные значения
+	OutputMin, OutputMax float64
+	// DerivativeFilter - постоянная времени фильтра дифференциальной
+	// составляющей; ноль отключает фильтр
+	DerivativeFilter time.Duration
+}
+
+// validate проверяет настройки регулятора.
+func (c Config) validate() error {
+	if !c.Scale.Valid() {
+		return fmt.Errorf("%w: %w", ErrInvalidConfig, tempconv.ErrUnknownScale)
+	}
+	// Градус Делиля отрицателен: рассогласование в этой шкале меняет знак, и
+	// регулятор с положительными коэффициентами работал бы в обратную сторону
+	if c.Scale == tempconv.ScaleDelisle {
+		return fmt.Errorf("%w: шкала %s обратная", ErrInvalidConfig, c.Scale.Symbol())
+	}
+	for _, k := range []float64{c.Kp, c.Ki, c.Kd} {
+		if k < 0 || math.IsNaN(k) || math.IsInf(k, 0) {
+			return fmt.Errorf("%w: коэффициент %v", ErrInvalidConfig, k)
+		}
+	}
+	if !(c.OutputMin < c.OutputMax) {
+		return fmt.Errorf("%w: пределы выхода [%v, %v]", ErrInvalidConfig, c.OutputMin, c.OutputMax)
+	}
+	if c.DerivativeFilter < 0 {
+		return fmt.Errorf("%w: постоянная времени фильтра %v", ErrInvalidConfig, c.DerivativeFilter)
+	}
+	return nil
+}
+
+// PID - ПИД-регулятор температуры. Дифференциальная составляющая вычисляется
+// по измеряемой величине, а не по рассогласованию, поэтому скачок уставки не
+// вызывает броска выхода. Регулятор не безопасен для одновременного
+// использования из нескольких горутин.
+type PID struct {
+	cfg      Config
+	setpoint tempconv.Temperature
+
+	integral   float64              // интегральная составляющая выхода
+	derivative float64              // отфильтрованная дифференциальная составляющая
+	pv         tempconv.Temperature // последнее показание; nil до первого шага
+	err        float64              // последнее рассогласование в градусах шкалы
+	output     float64
+}
+
+// New создает регулятор с настройками cfg и уставкой setpoint.
+func New(cfg Config, setpoint tempconv.Temperature) (*PID, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	if setpoint == nil {
+		return nil, ErrNilTemperature
+	}
+	return &PID{cfg: cfg, setpoint: setpoint}, nil
+}
+
+// Config возвращает текущие настройки регулятора.
+func (p *PID) Config() Config { return p.cfg }
+
+// Setpoint возвращает текущую уставку.
+func (p *PID) Setpoint() tempconv.Temperature { return p.setpoint }
+
+// Output возвращает выход регулятора, вычисленный на последнем шаге.
+func (p *PID) Output() float64 { return p.output }
+
+// Error возвращает рассогласование (уставка минус показание) на последнем
+// шаге в шкале настроек.
+func (p *PID) Error() tempconv.Delta {
+	return tempconv.Delta{Value: p.err, Scale: p.cfg.Scale}
+}
+
+// SetSetpoint изменяет уставку. Интегральная составляющая корректируется так,
+// чтобы выход регулятора при неизменном показании не изменился скачком.
+func (p *PID) SetSetpoint(sp tempconv.Temperature) error {
+	if sp == nil {
+		return ErrNilTemperature
+	}
+	if p.pv != nil {
+		e := p.errorAt(sp, p.pv)
+		p.integral = p.clamp(p.integral + p.cfg.Kp*(p.err-e))
+		p.err = e
+	}
+	p.setpoint = sp
+	return nil
+}
+
+// SetConfig изменяет настройки регулятора. Интегральная составляющая
+// корректируется так, чтобы выход регулятора не изменился скачком.
+func (p *PID) SetConfig(cfg Config) error {
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+	p.cfg = cfg
+	if p.pv != nil {
+		e := p.errorAt(p.setpoint, p.pv)
+		p.integral = p.output - cfg.Kp*e - p.derivative
+		p.err = e
+	}
+	p.integral = p.clamp(p.integral)
+	return nil
+}
+
+// Reset сбрасывает внутреннее состояние регулятора, сохраняя настройки и
+// уставку.
+func (p *PID) Reset() {
+	*p = PID{cfg: p.cfg, setpoint: p.setpoint}
+}
+
+// Step выполняет шаг регулирования по показанию pv, полученному через
+// интервал dt после предыдущего шага, и возвращает новый выход регулятора.
+// Результат зависит только от аргументов и состояния регулятора, поэтому
+// регулятор можно использовать в моделировании с произвольным шагом.
+func (p *PID) Step(pv tempconv.Temperature, dt time.Duration) (float64, error) {
+	if pv == nil {
+		return 0, ErrNilTemperature
+	}
+	if dt <= 0 {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidStep, dt)
+	}
+	sec := dt.Seconds()
+	e := p.errorAt(p.setpoint, pv)
+
+	if p.pv != nil && p.cfg.Kd != 0 {
+		// Производная по измеряемой величине с фильтром первого порядка
+		// (неявная схема Эйлера).
+		dpv := tempconv.DeltaBetween(pv, p.pv, p.cfg.Scale).Value
+		tf := p.cfg.DerivativeFilter.Seconds()
+		p.derivative = (tf*p.derivative - p.cfg.Kd*dpv) / (tf + sec)
+	}
+
+	prop := p.cfg.Kp * e
+	integral := p.clamp(p.integral + p.cfg.Ki*e*sec)
+	u := prop + integral + p.derivative
+
+	// Защита от насыщения: интегратор накапливается лишь до значения, при
+	// котором выход достигает предела, если рассогласование продолжает
+	// толкать выход дальше.
+	switch {
+	case u > p.cfg.OutputMax && e > 0:
+		integral = math.Min(integral, math.Max(p.integral, p.cfg.OutputMax-prop-p.derivative))
+		u = prop + integral + p.derivative
+	case u < p.cfg.OutputMin && e < 0:
+		integral = math.Max(integral, math.Min(p.integral, p.cfg.OutputMin-prop-p.derivative))
+		u = prop + integral + p.derivative
+	}
+
+	p.integral = integral
+	p.pv = pv
+	p.err = e
+	p.output = p.clamp(u)
+	return p.output, nil
+}
+
+// errorAt возвращает рассогласование sp - pv в градусах шкалы настроек.
+func (p *PID) errorAt(sp, pv tempconv.Temperature) float64 {
+	return tempconv.DeltaBetween(sp, pv, p.cfg.Scale).Value
+}
+
+// clamp ограничивает v пределами выхода регулятора.
+func (p *PID) clamp(v float64) float64 {
+	return math.Max(p.cfg.OutputMin, math.Min(p.cfg.OutputMax, v))
+}
@@ -0,0 +1,178 @@
+package control
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// almostEqual проверяет, что два числа почти равны с заданной погрешностью.
+func almostEqual(a, b float64, epsilon float64) bool {
+	return math.Abs(a-b) <= epsilon
+}
+
+// unlimited возвращает настройки с неограниченным выходом.
+func unlimited(kp, ki, kd float64) Config {
+	return Config{
+		Kp: kp, Ki: ki, Kd: kd,
+		Scale:     tempconv.ScaleCelsius,
+		OutputMin: math.Inf(-1),
+		OutputMax: math.Inf(1),
+	}
+}
+
+// TestNewErrors проверяет отклонение некорректных настроек.
+func TestNewErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+	}{
+		{"unknown scale", Config{Kp: 1, OutputMax: 1}},
+		{"Delisle scale", Config{Kp: 1, Scale: tempconv.ScaleDelisle, OutputMax: 1}},
+		{"negative gain", Config{Kp: -1, Scale: tempconv.ScaleCelsius, OutputMax: 1}},
+		{"NaN gain", Config{Ki: math.NaN(), Scale: tempconv.ScaleCelsius, OutputMax: 1}},
+		{"empty output range", Config{Kp: 1, Scale: tempconv.ScaleCelsius}},
+		{"negative filter", Config{Kp: 1, Scale: tempconv.ScaleCelsius, OutputMax: 1, DerivativeFilter: -time.Second}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(tt.cfg, tempconv.Celsius(20)); !errors.Is(err, ErrInvalidConfig) {
+				t.Errorf("New() error = %v, want %v", err, ErrInvalidConfig)
+			}
+		})
+	}
+	if _, err := New(unlimited(1, 0, 0), nil); !errors.Is(err, ErrNilTemperature) {
+		t.Errorf("New(nil) error = %v, want %v", err, ErrNilTemperature)
+	}
+}
+
+// TestErrorAcrossScales проверяет, что рассогласование вычисляется как
+// разность температур в шкале настроек.
+func TestErrorAcrossScales(t *testing.T) {
+	tests := []struct {
+		name     string
+		scale    tempconv.Scale
+		setpoint tempconv.Temperature
+		pv       tempconv.Temperature
+		want     float64
+	}{
+		{"C setpoint, F reading", tempconv.ScaleCelsius, tempconv.Celsius(200), tempconv.Fahrenheit(390), 10.0 / 9},
+		{"F setpoint, K reading", tempconv.ScaleFahrenheit, tempconv.Fahrenheit(212), tempconv.Kelvin(363.15), 18},
+		{"Delisle setpoint", tempconv.ScaleKelvin, tempconv.Delisle(0), tempconv.Celsius(90), 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := unlimited(1, 0, 0)
+			cfg.Scale = tt.scale
+			p, _ := New(cfg, tt.setpoint)
+			got, err := p.Step(tt.pv, time.Second)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !almostEqual(got, tt.want, 1e-9) {
+				t.Errorf("Step() = %v, want %v", got, tt.want)
+			}
+			if e := p.Error(); e.Scale != tt.scale || !almostEqual(e.Value, tt.want, 1e-9) {
+				t.Errorf("Error() = %v, want %v", e, tempconv.Delta{Value: tt.want, Scale: tt.scale})
+			}
+		})
+	}
+}
+
+// TestAntiWindup проверяет, что интегратор не накапливается, пока выход
+// находится в насыщении.
+func TestAntiWindup(t *testing.T) {
+	cfg := Config{Ki: 1, Scale: tempconv.ScaleCelsius, OutputMin: 0, OutputMax: 5}
+	p, _ := New(cfg, tempconv.Celsius(22))
+
+	var out float64
+	for i := 0; i < 10; i++ {
+		out, _ = p.Step(tempconv.Celsius(20), time.Second)
+	}
+	if out != 5 {
+		t.Fatalf("Step() = %v, want 5", out)
+	}
+	// Без защиты интегратор накопил бы 20 и выход оставался бы в насыщении.
+	out, _ = p.Step(tempconv.Celsius(23), time.Second)
+	if !almostEqual(out, 4, 1e-9) {
+		t.Errorf("Step() after overshoot = %v, want 4", out)
+	}
+}
+
+// TestDerivativeFilter проверяет дифференцирование по измеряемой величине и
+// фильтр первого порядка.
+func TestDerivativeFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter time.Duration
+		want   float64
+	}{
+		{"unfiltered", 0, -2},
+		{"filtered", time.Second, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := unlimited(0, 0, 1)
+			cfg.DerivativeFilter = tt.filter
+			p, _ := New(cfg, tempconv.Celsius(50))
+			if out, _ := p.Step(tempconv.Celsius(20), time.Second); out != 0 {
+				t.Errorf("first Step() = %v, want 0", out)
+			}
+			out, _ := p.Step(tempconv.Fahrenheit(71.6), time.Second) // 22°C
+			if !almostEqual(out, tt.want, 1e-9) {
+				t.Errorf("Step() = %v, want %v", out, tt.want)
+			}
+		})
+	}
+}
+
+// TestBumpless проверяет отсутствие скачка выхода при смене уставки и
+// коэффициентов.
+func TestBumpless(t *testing.T) {
+	p, _ := New(unlimited(2, 0, 0), tempconv.Celsius(25))
+	out, _ := p.Step(tempconv.Celsius(20), time.Second)
+	if out != 10 {
+		t.Fatalf("Step() = %v, want 10", out)
+	}
+
+	if err := p.SetSetpoint(tempconv.Fahrenheit(86)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, _ := p.Step(tempconv.Celsius(20), time.Second); !almostEqual(out, 10, 1e-9) {
+		t.Errorf("Step() after SetSetpoint = %v, want 10", out)
+	}
+
+	delisle := unlimited(5, 0, 0)
+	delisle.Scale = tempconv.ScaleDelisle
+	if err := p.SetConfig(delisle); !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("SetConfig() error = %v, want %v", err, ErrInvalidConfig)
+	}
+	if err := p.SetConfig(unlimited(5, 0, 0)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, _ := p.Step(tempconv.Celsius(20), time.Second); !almostEqual(out, 10, 1e-9) {
+		t.Errorf("Step() after SetConfig = %v, want 10", out)
+	}
+	// Дальнейшие изменения показания отрабатываются с новым коэффициентом.
+	if out, _ := p.Step(tempconv.Celsius(21), time.Second); !almostEqual(out, 5, 1e-9) {
+		t.Errorf("Step() = %v, want 5", out)
+	}
+}
+
+// TestStepErrors проверяет ошибки шага регулирования.
+func TestStepErrors(t *testing.T) {
+	p, _ := New(unlimited(1, 0, 0), tempconv.Celsius(20))
+
+	if _, err := p.Step(nil, time.Second); !errors.Is(err, ErrNilTemperature) {
+		t.Errorf("Step(nil) error = %v, want %v", err, ErrNilTemperature)
+	}
+	if _, err := p.Step(tempconv.Celsius(20), 0); !errors.Is(err, ErrInvalidStep) {
+		t.Errorf("Step(0) error = %v, want %v", err, ErrInvalidStep)
+	}
+}
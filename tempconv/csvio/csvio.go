@@ -0,0 +1,184 @@
+package csvio
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// Ошибки чтения и записи
+var (
+	ErrNoHeader       = errors.New("нет строки заголовков")
+	ErrInvalidValue   = errors.New("недопустимое значение температуры")
+	ErrFieldCount     = errors.New("неверное число полей")
+	ErrHeaderRequired = errors.New("заголовки не записаны")
+)
+
+// Record - строка CSV-файла.
+type Record struct {
+	// Fields - все поля строки в исходном виде
+	Fields []string
+	// Temperatures - температуры по номерам столбцов; nil для столбцов, не
+	// содержащих температуру, и для пустых ячеек
+	Temperatures []tempconv.Temperature
+}
+
+// Reader читает CSV-файл со столбцами температуры.
+type Reader struct {
+	r       *csv.Reader
+	header  []string
+	columns []Column
+}
+
+// NewReader создает Reader, читающий из r, и читает строку заголовков.
+func NewReader(r io.Reader) (*Reader, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, ErrNoHeader
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Reader{r: cr, header: header, columns: DetectColumns(header)}, nil
+}
+
+// Header возвращает строку заголовков в исходном виде.
+func (r *Reader) Header() []string { return slices.Clone(r.header) }
+
+// Columns возвращает найденные столбцы температуры.
+func (r *Reader) Columns() []Column { return slices.Clone(r.columns) }
+
+// Read читает следующую строку. Значения столбцов температуры проверяются на
+// абсолютный ноль; NaN и бесконечности отклоняются с ErrInvalidValue. В конце
+// файла возвращается io.EOF.
+func (r *Reader) Read() (Record, error) {
+	fields, err := r.r.Read()
+	if err != nil {
+		return Record{}, err
+	}
+	rec := Record{Fields: fields, Temperatures: make([]tempconv.Temperature, len(fields))}
+	for _, c := range r.columns {
+		s := strings.TrimSpace(fields[c.Index])
+		if s == "" {
+			continue
+		}
+		line, _ := r.r.FieldPos(c.Index)
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			return Record{}, fmt.Errorf("%w: строка %d, столбец %q: %q", ErrInvalidValue, line, r.header[c.Index], s)
+		}
+		if rec.Temperatures[c.Index], err = c.Scale.New(v); err != nil {
+			return Record{}, fmt.Errorf("строка %d, столбец %q: %w", line, r.header[c.Index], err)
+		}
+	}
+	return rec, nil
+}
+
+// Writer записывает CSV-файл, преобразуя температуры в заданную шкалу.
+type Writer struct {
+	w      *csv.Writer
+	scale  tempconv.Scale
+	scales []tempconv.Scale // шкалы столбцов по номерам; 0 для прочих столбцов
+}
+
+// NewWriter создает Writer, записывающий в w. Температуры преобразуются в
+// шкалу scale; при нулевом значении каждый столбец сохраняет шкалу,
+// указанную в его заголовке. Для ненулевой недопустимой шкалы возвращается
+// ошибка ErrUnknownScale.
+func NewWriter(w io.Writer, scale tempconv.Scale) (*Writer, error) {
+	if scale != 0 && !scale.Valid() {
+		return nil, fmt.Errorf("%w: %v", tempconv.ErrUnknownScale, scale)
+	}
+	return &Writer{w: csv.NewWriter(w), scale: scale}, nil
+}
+
+// WriteHeader записывает строку заголовков. Заголовки столбцов температуры
+// записываются с обозначением шкалы записи.
+func (w *Writer) WriteHeader(header []string) error {
+	out := slices.Clone(header)
+	w.scales = make([]tempconv.Scale, len(header))
+	for _, c := range DetectColumns(header) {
+		scale := c.Scale
+		if w.scale != 0 {
+			scale = w.scale
+		}
+		out[c.Index] = FormatHeader(c.Name, scale)
+		w.scales[c.Index] = scale
+	}
+	return w.w.Write(out)
+}
+
+// Write записывает строку. Температуры из rec.Temperatures в столбцах
+// температуры преобразуются в шкалу столбца и записываются с 12 значащими
+// цифрами; остальные поля записываются из rec.Fields.
+func (w *Writer) Write(rec Record) error {
+	if w.scales == nil {
+		return ErrHeaderRequired
+	}
+	if len(rec.Fields) != len(w.scales) {
+		return fmt.Errorf("%w: %d вместо %d", ErrFieldCount, len(rec.Fields), len(w.scales))
+	}
+	out := slices.Clone(rec.Fields)
+	for i, t := range rec.Temperatures {
+		if t == nil || i >= len(out) || w.scales[i] == 0 {
+			continue
+		}
+		out[i] = formatValue(tempconv.ValueOf(w.scales[i].Convert(t)))
+	}
+	return w.w.Write(out)
+}
+
+// Flush записывает буферизованные данные и возвращает ошибку записи, если
+// она произошла.
+func (w *Writer) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+// Convert читает CSV-файл из src, проверяет значения столбцов температуры,
+// преобразует их в шкалу scale и записывает результат в dst.
+func Convert(dst io.Writer, src io.Reader, scale tempconv.Scale) error {
+	if !scale.Valid() {
+		return fmt.Errorf("%w: %v", tempconv.ErrUnknownScale, scale)
+	}
+	r, err := NewReader(src)
+	if err != nil {
+		return err
+	}
+	w, err := NewWriter(dst, scale)
+	if err != nil {
+		return err
+	}
+	if err := w.WriteHeader(r.Header()); err != nil {
+		return err
+	}
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := w.Write(rec); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// formatValue форматирует значение температуры с 12 значащими цифрами без
+// экспоненты, отбрасывая погрешность преобразования между шкалами
+// (37.00000000000001 записывается как 37).
+func formatValue(v float64) string {
+	r, _ := strconv.ParseFloat(strconv.FormatFloat(v, 'g', 12, 64), 64)
+	return strconv.FormatFloat(r, 'f', -1, 64)
+}
@@ -0,0 +1,128 @@
+package csvio
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// TestReader проверяет разбор и проверку значений столбцов температуры.
+func TestReader(t *testing.T) {
+	input := "time,temp (°F),site\n08:00,98.6,a\n09:00,,b\n"
+	r, err := NewReader(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cols := r.Columns(); len(cols) != 1 || cols[0].Index != 1 {
+		t.Fatalf("Columns() = %v, want one column at index 1", cols)
+	}
+
+	rec, err := r.Read()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rec.Temperatures[1] != tempconv.Fahrenheit(98.6) || rec.Temperatures[0] != nil || rec.Fields[2] != "a" {
+		t.Errorf("Read() = %+v, want 98.6°F in column 1", rec)
+	}
+
+	rec, err = r.Read()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rec.Temperatures[1] != nil {
+		t.Errorf("empty cell = %v, want nil", rec.Temperatures[1])
+	}
+	if _, err := r.Read(); err != io.EOF {
+		t.Errorf("Read() at end error = %v, want io.EOF", err)
+	}
+}
+
+// TestReaderErrors проверяет ошибки чтения.
+func TestReaderErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   error
+	}{
+		{"below absolute zero", "T [K]\n-5\n", tempconv.ErrBelowAbsoluteZero},
+		{"Delisle above limit", "T (°De)\n600\n", tempconv.ErrBelowAbsoluteZero},
+		{"not a number", "T [K]\nwarm\n", ErrInvalidValue},
+		{"NaN", "T [K]\nNaN\n", ErrInvalidValue},
+		{"infinity", "temp (°F)\n+Inf\n", ErrInvalidValue},
+		{"negative infinity", "T (°De)\n-Inf\n", ErrInvalidValue},
+		{"overflow", "T [K]\n1e999\n", ErrInvalidValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewReader(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if _, err := r.Read(); !errors.Is(err, tt.err) {
+				t.Errorf("Read() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+
+	if _, err := NewReader(strings.NewReader("")); !errors.Is(err, ErrNoHeader) {
+		t.Errorf("NewReader() error = %v, want %v", err, ErrNoHeader)
+	}
+}
+
+// TestConvert проверяет преобразование файла в другую шкалу с
+// переписыванием заголовков.
+func TestConvert(t *testing.T) {
+	input := "time,inlet (°F),outlet [K],note\n08:00,98.6,300,\"warm, dry\"\n09:00,32,,\n"
+	want := "time,inlet (°C),outlet (°C),note\n08:00,37,26.85,\"warm, dry\"\n09:00,0,,\n"
+
+	var b strings.Builder
+	if err := Convert(&b, strings.NewReader(input), tempconv.ScaleCelsius); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if b.String() != want {
+		t.Errorf("Convert() =\n%s\nwant\n%s", b.String(), want)
+	}
+
+	if err := Convert(&b, strings.NewReader(input), 0); !errors.Is(err, tempconv.ErrUnknownScale) {
+		t.Errorf("Convert() error = %v, want %v", err, tempconv.ErrUnknownScale)
+	}
+}
+
+// TestWriterKeepsColumnScale проверяет, что без заданной шкалы температура
+// записывается в шкале столбца, даже если задана в другой шкале.
+func TestWriterKeepsColumnScale(t *testing.T) {
+	var b strings.Builder
+	if _, err := NewWriter(&b, 42); !errors.Is(err, tempconv.ErrUnknownScale) {
+		t.Errorf("NewWriter() error = %v, want %v", err, tempconv.ErrUnknownScale)
+	}
+	w, err := NewWriter(&b, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := w.Write(Record{Fields: []string{"1"}}); !errors.Is(err, ErrHeaderRequired) {
+		t.Errorf("Write() error = %v, want %v", err, ErrHeaderRequired)
+	}
+	if err := w.WriteHeader([]string{"id", "temp (fahrenheit)"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rec := Record{
+		Fields:       []string{"1", ""},
+		Temperatures: []tempconv.Temperature{nil, tempconv.Celsius(100)},
+	}
+	if err := w.Write(rec); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := w.Write(Record{Fields: []string{"1"}}); !errors.Is(err, ErrFieldCount) {
+		t.Errorf("Write() error = %v, want %v", err, ErrFieldCount)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "id,temp (°F)\n1,212\n"; b.String() != want {
+		t.Errorf("output = %q, want %q", b.String(), want)
+	}
+}
@@ -0,0 +1,21 @@
+// Пакет csvio содержит чтение и запись CSV-файлов со столбцами температуры.
+//
+// Столбцы температуры определяются по заголовкам, в которых шкала указана в
+// круглых или квадратных скобках: "temp (°F)", "T [K]", "Температура (celsius)".
+// Допускаются только однозначные обозначения: со знаком градуса, "K" и полные
+// названия шкал. Заголовки вроде "Force (N)" или "Charge (C)" относятся к
+// другим единицам, и такие столбцы, как и остальные, передаются без изменений.
+//
+// Reader разбирает значения столбцов температуры и проверяет их на
+// абсолютный ноль; пустые ячейки считаются пропусками, а NaN и бесконечности
+// отклоняются с ErrInvalidValue. Writer преобразует температуры в заданную
+// шкалу и записывает заголовки с соответствующим обозначением, поэтому шкала
+// столбца всегда указана явно. Convert выполняет оба шага сразу.
+//
+// # Пример использования:
+//
+//	// temp (°F),site  ->  temp (°C),site
+//	if err := csvio.Convert(os.Stdout, os.Stdin, tempconv.ScaleCelsius); err != nil {
+//	    fmt.Println("Ошибка:", err)
+//	}
+package csvio
@@ -0,0 +1,87 @@
+package csvio
+
+import (
+	"strings"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// Column - столбец температуры.
+type Column struct {
+	// Index - номер столбца, начиная с нуля
+	Index int
+	// Name - название столбца без обозначения шкалы
+	Name string
+	// Scale - шкала значений столбца
+	Scale tempconv.Scale
+}
+
+// ParseHeader разбирает заголовок столбца вида "temp (°F)", "T [K]" или
+// "temp (celsius)" и возвращает название столбца без обозначения шкалы и
+// шкалу. Если заголовок не содержит однозначного обозначения шкалы, ok равно
+// false: "Force (N)" и "Charge (C)" не считаются столбцами температуры.
+func ParseHeader(h string) (name string, scale tempconv.Scale, ok bool) {
+	s := strings.TrimSpace(h)
+	if s == "" {
+		return "", 0, false
+	}
+	var open byte
+	switch s[len(s)-1] {
+	case ')':
+		open = '('
+	case ']':
+		open = '['
+	default:
+		return "", 0, false
+	}
+	i := strings.LastIndexByte(s, open)
+	if i < 0 {
+		return "", 0, false
+	}
+	scale, ok = headerScale(s[i+1 : len(s)-1])
+	if !ok {
+		return "", 0, false
+	}
+	return strings.TrimSpace(s[:i]), scale, true
+}
+
+// headerScale разбирает обозначение шкалы в заголовке. Допускаются только
+// однозначные обозначения: со знаком градуса ("°C", "°Re"), "K" и полные
+// названия шкал ("celsius", "Réaumur"). Обозначения без знака градуса ("C",
+// "N", "R") в заголовках обычно означают другие единицы (кулоны, ньютоны,
+// омы), поэтому такие столбцы не считаются столбцами температуры.
+func headerScale(unit string) (tempconv.Scale, bool) {
+	u := strings.TrimSpace(unit)
+	scale, err := tempconv.ParseScale(u)
+	if err != nil {
+		return 0, false
+	}
+	switch {
+	case strings.HasPrefix(u, "°"), strings.HasPrefix(u, "º"):
+		return scale, true
+	case u == "K", strings.EqualFold(u, scale.String()), strings.EqualFold(u, "réaumur"):
+		return scale, true
+	}
+	return 0, false
+}
+
+// FormatHeader возвращает заголовок столбца температуры, например
+// "temp (°F)" или "T (K)".
+func FormatHeader(name string, scale tempconv.Scale) string {
+	if name == "" {
+		return "(" + scale.Symbol() + ")"
+	}
+	return name + " (" + scale.Symbol() + ")"
+}
+
+// DetectColumns возвращает столбцы температуры, найденные в строке
+// заголовков.
+func DetectColumns(header []string) []Column {
+	var cols []Column
+	for i, h := range header {
+		if name, scale, ok := ParseHeader(h); ok {
+			cols = append(cols, Column{Index: i, Name: name, Scale: scale})
+		}
+	}
+	return cols
+}
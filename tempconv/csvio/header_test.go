@@ -0,0 +1,90 @@
+package csvio
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// TestParseHeader проверяет распознавание обозначений шкал в заголовках.
+func TestParseHeader(t *testing.T) {
+	tests := []struct {
+		input string
+		name  string
+		scale tempconv.Scale
+		ok    bool
+	}{
+		{"temp (°F)", "temp", tempconv.ScaleFahrenheit, true},
+		{"T [K]", "T", tempconv.ScaleKelvin, true},
+		{" Температура (°C) ", "Температура", tempconv.ScaleCelsius, true},
+		{"temp (Fahrenheit)", "temp", tempconv.ScaleFahrenheit, true},
+		{"temp (réaumur)", "temp", tempconv.ScaleReaumur, true},
+		{"t (ºN)", "t", tempconv.ScaleNewton, true},
+		{"outlet temp (avg) [°Re]", "outlet temp (avg)", tempconv.ScaleReaumur, true},
+		{"(kelvin)", "", tempconv.ScaleKelvin, true},
+		{"pressure (hPa)", "", 0, false},
+		// Обозначения без знака градуса совпадают с другими единицами
+		{"Force (N)", "", 0, false},
+		{"Resistance (R)", "", 0, false},
+		{"Charge (C)", "", 0, false},
+		{"temp (F)", "", 0, false},
+		{"distance [Re]", "", 0, false},
+		{"mass (k)", "", 0, false},
+		{"temp_f", "", 0, false},
+		{"temp (°F]", "", 0, false},
+		{"", "", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			name, scale, ok := ParseHeader(tt.input)
+			if name != tt.name || scale != tt.scale || ok != tt.ok {
+				t.Errorf("ParseHeader() = %q, %v, %v; want %q, %v, %v", name, scale, ok, tt.name, tt.scale, tt.ok)
+			}
+		})
+	}
+}
+
+// TestConvertKeepsOtherUnits проверяет, что Convert не изменяет столбцы с
+// единицами, обозначения которых совпадают с обозначениями шкал.
+func TestConvertKeepsOtherUnits(t *testing.T) {
+	input := "Force (N),Charge (C),Resistance (R),temp (°F)\n10,20,30,212\n"
+	var b strings.Builder
+	if err := Convert(&b, strings.NewReader(input), tempconv.ScaleCelsius); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "Force (N),Charge (C),Resistance (R),temp (°C)\n10,20,30,100\n"; b.String() != want {
+		t.Errorf("output = %q, want %q", b.String(), want)
+	}
+}
+
+// TestFormatHeader проверяет, что записанные заголовки распознаются обратно.
+func TestFormatHeader(t *testing.T) {
+	for _, s := range tempconv.Scales() {
+		h := FormatHeader("temp", s)
+		if name, scale, ok := ParseHeader(h); !ok || name != "temp" || scale != s {
+			t.Errorf("ParseHeader(%q) = %q, %v, %v; want temp, %v, true", h, name, scale, ok, s)
+		}
+	}
+	if h := FormatHeader("T", tempconv.ScaleKelvin); h != "T (K)" {
+		t.Errorf("FormatHeader() = %q, want %q", h, "T (K)")
+	}
+}
+
+// TestDetectColumns проверяет поиск столбцов температуры.
+func TestDetectColumns(t *testing.T) {
+	cols := DetectColumns([]string{"time", "inlet (°C)", "rh (%)", "outlet [°F]", "force (N)"})
+	want := []Column{
+		{Index: 1, Name: "inlet", Scale: tempconv.ScaleCelsius},
+		{Index: 3, Name: "outlet", Scale: tempconv.ScaleFahrenheit},
+	}
+	if len(cols) != len(want) {
+		t.Fatalf("DetectColumns() = %v, want %v", cols, want)
+	}
+	for i := range want {
+		if cols[i] != want[i] {
+			t.Errorf("column %d = %+v, want %+v", i, cols[i], want[i])
+		}
+	}
+}
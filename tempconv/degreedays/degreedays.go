@@ -0,0 +1,277 @@
+package degreedays
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+	"github.com/MiCkEyZzZ/tempconv/tempconv/series"
+)
+
+// Ошибки расчета градусо-суток
+var (
+	ErrInvalidDay     = errors.New("недопустимые суточные данные")
+	ErrUnknownMethod  = errors.New("неизвестный метод расчета градусо-суток")
+	ErrUnknownKind    = errors.New("неизвестный вид градусо-суток")
+	ErrNilTemperature = errors.New("не задана температура")
+)
+
+// Kind - вид градусо-суток.
+type Kind int
+
+// Виды градусо-суток
+const (
+	// Heating - градусо-сутки отопления: недостаток температуры относительно базы
+	Heating Kind = iota
+	// Cooling - градусо-сутки охлаждения: превышение температуры над базой
+	Cooling
+)
+
+// String возвращает название вида градусо-суток.
+func (k Kind) String() string {
+	switch k {
+	case Heating:
+		return "heating"
+	case Cooling:
+		return "cooling"
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Method - метод расчета градусо-суток по суточным данным.
+type Method int
+
+// Методы расчета
+const (
+	// MeanTemperature - по средней суточной температуре
+	MeanTemperature Method = iota
+	// MetOffice - по уравнениям Британской метеорологической службы
+	MetOffice
+	// SingleSine - одинарная синусоида
+	SingleSine
+	// DoubleSine - двойная синусоида
+	DoubleSine
+)
+
+// String возвращает название метода расчета.
+func (m Method) String() string {
+	switch m {
+	case MeanTemperature:
+		return "mean"
+	case MetOffice:
+		return "Met Office"
+	case SingleSine:
+		return "single sine"
+	case DoubleSine:
+		return "double sine"
+	}
+	return fmt.Sprintf("Method(%d)", int(m))
+}
+
+// Day - суточный минимум и максимум температуры. Температуры могут быть
+// заданы в разных шкалах.
+type Day struct {
+	// Min - минимальная температура за сутки
+	Min tempconv.Temperature
+	// Max - максимальная температура за сутки
+	Max tempconv.Temperature
+}
+
+// DegreeDays - количество градусо-суток в цене деления шкалы Scale.
+type DegreeDays struct {
+	// Value - количество градусо-суток
+	Value float64
+	// Scale - шкала, в градусах которой выражен результат
+	Scale tempconv.Scale
+}
+
+// In переводит градусо-сутки в шкалу s по правилам разности температур.
+func (d DegreeDays) In(s tempconv.Scale) DegreeDays {
+	return DegreeDays{Value: tempconv.Delta(d).In(s).Value, Scale: s}
+}
+
+// String возвращает строковое представление, например "15.00 °F·сут".
+func (d DegreeDays) String() string {
+	return fmt.Sprintf("%.2f %s·сут", d.Value, d.Scale.Symbol())
+}
+
+// day - суточные данные в кельвинах.
+type day struct {
+	min, max float64
+}
+
+// Daily возвращает градусо-сутки за одни сутки. Для метода DoubleSine
+// минимум следующих суток считается равным минимуму текущих.
+func Daily(d Day, base tempconv.Temperature, kind Kind, method Method) (DegreeDays, error) {
+	return Total([]Day{d}, base, kind, method)
+}
+
+// Total возвращает сумму градусо-суток за последовательность суток days.
+// Для метода DoubleSine вторая половина суток строится по минимуму следующих
+// суток, а для последних суток - по их собственному минимуму.
+func Total(days []Day, base tempconv.Temperature, kind Kind, method Method) (DegreeDays, error) {
+	if err := validate(base, kind); err != nil {
+		return DegreeDays{}, err
+	}
+	if method < MeanTemperature || method > DoubleSine {
+		return DegreeDays{}, fmt.Errorf("%w: %v", ErrUnknownMethod, method)
+	}
+	b := float64(base.ToKelvin())
+
+	converted := make([]day, len(days))
+	for i, d := range days {
+		if d.Min == nil || d.Max == nil {
+			return DegreeDays{}, fmt.Errorf("%w: сутки %d: %w", ErrInvalidDay, i, ErrNilTemperature)
+		}
+		lo, hi := float64(d.Min.ToKelvin()), float64(d.Max.ToKelvin())
+		if lo > hi {
+			return DegreeDays{}, fmt.Errorf("%w: сутки %d: минимум %v выше максимума %v", ErrInvalidDay, i, d.Min, d.Max)
+		}
+		converted[i] = day{min: lo, max: hi}
+	}
+
+	var total float64
+	for i, d := range converted {
+		switch method {
+		case MeanTemperature:
+			total += above((d.min+d.max)/2, b, kind)
+		case MetOffice:
+			total += metOffice(d, b, kind)
+		case SingleSine:
+			total += sine(d.min, d.max, b, kind)
+		case DoubleSine:
+			next := d.min
+			if i+1 < len(converted) {
+				next = converted[i+1].min
+			}
+			total += (sine(d.min, d.max, b, kind) + sine(math.Min(next, d.max), d.max, b, kind)) / 2
+		}
+	}
+	return result(total, base), nil
+}
+
+// FromSeries интегрирует отклонение температуры от базы по временному ряду s
+// с линейной интерполяцией между показаниями и возвращает градусо-сутки за
+// весь интервал ряда.
+func FromSeries(s *series.Series, base tempconv.Temperature, kind Kind) (DegreeDays, error) {
+	if err := validate(base, kind); err != nil {
+		return DegreeDays{}, err
+	}
+	if s.Len() == 0 {
+		return DegreeDays{}, series.ErrEmptySeries
+	}
+	b := float64(base.ToKelvin())
+
+	sorted, err := s.Convert(tempconv.ScaleKelvin)
+	if err != nil {
+		return DegreeDays{}, err
+	}
+	sorted.Sort()
+
+	var total float64 // К·ч
+	for i := 1; i < sorted.Len(); i++ {
+		prev, cur := sorted.At(i-1), sorted.At(i)
+		hours := cur.Time.Sub(prev.Time).Hours()
+		total += hours * segment(signed(prev.Value, b, kind), signed(cur.Value, b, kind))
+	}
+	return result(total/hoursPerDay, base), nil
+}
+
+// hoursPerDay - количество часов в сутках
+const hoursPerDay = 24.0
+
+// validate проверяет базовую температуру и вид градусо-суток.
+func validate(base tempconv.Temperature, kind Kind) error {
+	if base == nil {
+		return ErrNilTemperature
+	}
+	if kind != Heating && kind != Cooling {
+		return fmt.Errorf("%w: %v", ErrUnknownKind, kind)
+	}
+	return nil
+}
+
+// result выражает градусо-сутки, накопленные в кельвинах, в шкале базовой
+// температуры.
+func result(kelvinDays float64, base tempconv.Temperature) DegreeDays {
+	scale := tempconv.ScaleOf(base)
+	if !scale.Valid() {
+		scale = tempconv.ScaleKelvin
+	}
+	return DegreeDays{Value: kelvinDays, Scale: tempconv.ScaleKelvin}.In(scale)
+}
+
+// signed возвращает отклонение t от базы b со знаком: для отопления
+// положительно, когда t ниже базы.
+func signed(t, b float64, kind Kind) float64 {
+	if kind == Heating {
+		return b - t
+	}
+	return t - b
+}
+
+// above возвращает положительную часть отклонения t от базы b.
+func above(t, b float64, kind Kind) float64 { return math.Max(0, signed(t, b, kind)) }
+
+// segment возвращает среднее значение положительной части отклонения,
+// линейно меняющегося на отрезке от d0 до d1.
+func segment(d0, d1 float64) float64 {
+	switch {
+	case d0 >= 0 && d1 >= 0:
+		return (d0 + d1) / 2
+	case d0 <= 0 && d1 <= 0:
+		return 0
+	}
+	// Отклонение пересекает ноль: учитывается только треугольник над нулем
+	pos := math.Max(d0, d1)
+	return pos * pos / (2 * (math.Abs(d0) + math.Abs(d1)))
+}
+
+// metOffice вычисляет градусо-сутки по уравнениям Британской
+// метеорологической службы.
+func metOffice(d day, b float64, kind Kind) float64 {
+	mean := (d.min + d.max) / 2
+	if kind == Heating {
+		switch {
+		case d.max <= b:
+			return b - mean
+		case mean <= b:
+			return (b-d.min)/2 - (d.max-b)/4
+		case d.min < b:
+			return (b - d.min) / 4
+		}
+		return 0
+	}
+	switch {
+	case d.min >= b:
+		return mean - b
+	case mean >= b:
+		return (d.max-b)/2 - (b-d.min)/4
+	case d.max > b:
+		return (d.max - b) / 4
+	}
+	return 0
+}
+
+// sine вычисляет градусо-сутки для суточного хода температуры, описанного
+// синусоидой между min и max.
+func sine(lo, hi, b float64, kind Kind) float64 {
+	mean, amp := (lo+hi)/2, (hi-lo)/2
+	var cooling float64
+	switch {
+	case b >= hi:
+		cooling = 0
+	case b <= lo:
+		cooling = mean - b
+	default:
+		theta := math.Asin((b - mean) / amp)
+		cooling = ((mean-b)*(math.Pi/2-theta) + amp*math.Cos(theta)) / math.Pi
+	}
+	if kind == Cooling {
+		return cooling
+	}
+	// Интеграл (T - b) за сутки равен (mean - b), поэтому
+	// ∫max(0, b - T) = ∫max(0, T - b) - (mean - b).
+	return cooling - (mean - b)
+}
@@ -0,0 +1,151 @@
+package degreedays
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+	"github.com/MiCkEyZzZ/tempconv/tempconv/series"
+)
+
+// almostEqual проверяет, что два числа почти равны с заданной погрешностью.
+func almostEqual(a, b float64, epsilon float64) bool {
+	return math.Abs(a-b) <= epsilon
+}
+
+// celsiusDay создает суточные данные в градусах Цельсия.
+func celsiusDay(lo, hi float64) Day {
+	return Day{Min: tempconv.Celsius(lo), Max: tempconv.Celsius(hi)}
+}
+
+// TestDaily проверяет методы расчета по суточным данным при базе 15.5°C.
+func TestDaily(t *testing.T) {
+	base := tempconv.Celsius(15.5)
+	tests := []struct {
+		day      Day
+		kind     Kind
+		method   Method
+		expected float64
+	}{
+		{celsiusDay(5, 15), Heating, MeanTemperature, 5.5},
+		{celsiusDay(16, 25), Heating, MeanTemperature, 0},
+		{celsiusDay(20, 30), Cooling, MeanTemperature, 9.5},
+
+		// Уравнения Met Office для всех четырех случаев
+		{celsiusDay(2, 10), Heating, MetOffice, 9.5},
+		{celsiusDay(8, 20), Heating, MetOffice, 2.625},
+		{celsiusDay(12, 25), Heating, MetOffice, 0.875},
+		{celsiusDay(16, 25), Heating, MetOffice, 0},
+		{celsiusDay(10, 25), Cooling, MetOffice, 3.375},
+
+		// Синусоида: база за пределами суточного хода совпадает со средней
+		{celsiusDay(2, 10), Heating, SingleSine, 9.5},
+		{celsiusDay(5.5, 25.5), Heating, SingleSine, 10 / math.Pi},
+		{celsiusDay(5.5, 25.5), Cooling, SingleSine, 10 / math.Pi},
+		{celsiusDay(5.5, 25.5), Cooling, DoubleSine, 10 / math.Pi},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v %v %v", tt.method, tt.kind, tt.day), func(t *testing.T) {
+			got, err := Daily(tt.day, base, tt.kind, tt.method)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Scale != tempconv.ScaleCelsius {
+				t.Errorf("Scale = %v, want Celsius", got.Scale)
+			}
+			if !almostEqual(got.Value, tt.expected, 1e-9) {
+				t.Errorf("Daily() = %v, want %v", got.Value, tt.expected)
+			}
+		})
+	}
+}
+
+// TestFahrenheitBase проверяет, что результат выражается в шкале базы и
+// переводится между шкалами как разность температур.
+func TestFahrenheitBase(t *testing.T) {
+	days := []Day{
+		{Min: tempconv.Fahrenheit(40), Max: tempconv.Fahrenheit(60)},
+		{Min: tempconv.Celsius(0), Max: tempconv.Celsius(10)}, // среднее 41°F
+	}
+	hdd, err := Total(days, tempconv.Fahrenheit(65), Heating, MeanTemperature)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hdd.Scale != tempconv.ScaleFahrenheit || !almostEqual(hdd.Value, 39, 1e-9) {
+		t.Errorf("Total() = %v, want 39.00 °F·сут", hdd)
+	}
+	if c := hdd.In(tempconv.ScaleCelsius); !almostEqual(c.Value, 39*5.0/9, 1e-9) {
+		t.Errorf("In(Celsius) = %v, want %v", c.Value, 39*5.0/9)
+	}
+	if got := hdd.String(); got != "39.00 °F·сут" {
+		t.Errorf("String() = %v", got)
+	}
+}
+
+// TestDoubleSine проверяет использование минимума следующих суток.
+func TestDoubleSine(t *testing.T) {
+	base := tempconv.Celsius(10)
+	days := []Day{celsiusDay(0, 20), celsiusDay(10, 20)}
+	got, err := Total(days, base, Cooling, DoubleSine)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Первые сутки: половина синусоиды 0..20 (10/π) и половина 10..20 (5);
+	// вторые сутки: 10..20 целиком (5).
+	want := (10/math.Pi+5)/2 + 5
+	if !almostEqual(got.Value, want, 1e-9) {
+		t.Errorf("Total() = %v, want %v", got.Value, want)
+	}
+}
+
+// TestFromSeries проверяет интегрирование по временному ряду, включая
+// пересечение базы между показаниями.
+func TestFromSeries(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s, _ := series.New(tempconv.ScaleFahrenheit)
+	_ = s.Append(t0.Add(12*time.Hour), tempconv.Celsius(20))
+	_ = s.Append(t0, tempconv.Celsius(10))
+	_ = s.Append(t0.Add(24*time.Hour), tempconv.Celsius(10))
+
+	cdd, err := FromSeries(s, tempconv.Celsius(15), Cooling)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Треугольники над базой: два по 6 ч высотой 5 К - 30 К·ч = 1.25 К·сут
+	if !almostEqual(cdd.Value, 1.25, 1e-9) {
+		t.Errorf("FromSeries() = %v, want 1.25", cdd.Value)
+	}
+	hdd, _ := FromSeries(s, tempconv.Celsius(15), Heating)
+	if !almostEqual(hdd.Value, 1.25, 1e-9) {
+		t.Errorf("FromSeries() = %v, want 1.25", hdd.Value)
+	}
+
+	empty, _ := series.New(tempconv.ScaleCelsius)
+	if _, err := FromSeries(empty, tempconv.Celsius(15), Heating); !errors.Is(err, series.ErrEmptySeries) {
+		t.Errorf("expected error %v, got %v", series.ErrEmptySeries, err)
+	}
+}
+
+// TestErrors проверяет обработку некорректных входных данных.
+func TestErrors(t *testing.T) {
+	base := tempconv.Celsius(15.5)
+	if _, err := Daily(celsiusDay(10, 5), base, Heating, MeanTemperature); !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("expected error %v, got %v", ErrInvalidDay, err)
+	}
+	if _, err := Daily(Day{Min: tempconv.Celsius(1)}, base, Heating, MeanTemperature); !errors.Is(err, ErrNilTemperature) {
+		t.Errorf("expected error %v, got %v", ErrNilTemperature, err)
+	}
+	if _, err := Daily(celsiusDay(5, 10), base, Kind(5), MeanTemperature); !errors.Is(err, ErrUnknownKind) {
+		t.Errorf("expected error %v, got %v", ErrUnknownKind, err)
+	}
+	if _, err := Daily(celsiusDay(5, 10), base, Heating, Method(9)); !errors.Is(err, ErrUnknownMethod) {
+		t.Errorf("expected error %v, got %v", ErrUnknownMethod, err)
+	}
+	if _, err := Daily(celsiusDay(5, 10), nil, Heating, MeanTemperature); !errors.Is(err, ErrNilTemperature) {
+		t.Errorf("expected error %v, got %v", ErrNilTemperature, err)
+	}
+}
@@ -0,0 +1,40 @@
+// Пакет degreedays содержит расчет градусо-суток отопления (HDD) и охлаждения
+// (CDD) по суточным минимумам и максимумам температуры или по временному
+// ряду показаний.
+//
+// Базовая температура может быть задана в любой шкале. Градусо-сутки - это
+// накопленная разность температур, а не температура, поэтому расчет ведется
+// в кельвинах, а результат DegreeDays выражается в цене деления шкалы базовой
+// температуры: база 65°F дает результат в °F·сут, база 18°C - в °C·сут.
+// DegreeDays.In переводит результат в другую шкалу по правилам разности
+// температур.
+//
+// # Методы расчета по суточным данным:
+//
+// - MeanTemperature — по средней суточной температуре,
+//
+// - MetOffice       — по уравнениям Британской метеорологической службы,
+//
+// - SingleSine      — одинарная синусоида (Baskerville, Emin, 1969),
+//
+// - DoubleSine      — двойная синусоида с минимумом следующих суток.
+//
+// FromSeries интегрирует отклонение от базы по временному ряду показаний с
+// линейной интерполяцией между ними.
+//
+// # Агрономические суммы температур:
+//
+// GrowingDegreeDays вычисляет сумму эффективных температур (GDD) с нижним и
+// верхним порогами развития и горизонтальной или вертикальной отсечкой,
+// CropHeatUnits - тепловые единицы Онтарио (CHU) для кукурузы.
+//
+// # Пример использования:
+//
+//	days := []degreedays.Day{{Min: tempconv.Fahrenheit(40), Max: tempconv.Fahrenheit(60)}}
+//	hdd, err := degreedays.Total(days, tempconv.Fahrenheit(65), degreedays.Heating, degreedays.MeanTemperature)
+//	if err != nil {
+//	    fmt.Println("Ошибка:", err)
+//	    return
+//	}
+//	fmt.Println(hdd) // 15.00 °F·сут
+package degreedays
@@ -0,0 +1,167 @@
+package degreedays
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// Ошибки расчета агрономических сумм температур
+var (
+	ErrInvalidThresholds = errors.New("недопустимые пороги развития")
+	ErrUnknownCutoff     = errors.New("неизвестный способ отсечки")
+)
+
+// Cutoff - способ учета температур выше верхнего порога развития.
+type Cutoff int
+
+// Способы отсечки
+const (
+	// HorizontalCutoff - температура выше верхнего порога считается равной порогу
+	HorizontalCutoff Cutoff = iota
+	// VerticalCutoff - выше верхнего порога развитие прекращается
+	VerticalCutoff
+)
+
+// String возвращает название способа отсечки.
+func (c Cutoff) String() string {
+	switch c {
+	case HorizontalCutoff:
+		return "horizontal"
+	case VerticalCutoff:
+		return "vertical"
+	}
+	return fmt.Sprintf("Cutoff(%d)", int(c))
+}
+
+// Thresholds - нижний и верхний пороги развития культуры или вредителя.
+type Thresholds struct {
+	// Lower - нижний порог (базовая температура)
+	Lower tempconv.Temperature
+	// Upper - верхний порог; nil, если верхний порог не используется
+	Upper tempconv.Temperature
+}
+
+// Коэффициенты Ontario Crop Heat Units (°C)
+const (
+	chuMaxBase   = 10.0
+	chuMaxLinear = 3.33
+	chuMaxQuad   = 0.084
+	chuMinBase   = 4.44
+	chuMinLinear = 1.8
+)
+
+// GrowingDegreeDays возвращает сумму эффективных температур (GDD) за
+// последовательность суток days. Поддерживаются методы MeanTemperature и
+// SingleSine. Для метода MeanTemperature горизонтальная отсечка ограничивает
+// минимум и максимум суток порогами, а вертикальная обнуляет сутки со
+// средней температурой выше верхнего порога. Результат выражается в цене
+// деления шкалы нижнего порога: °C·сут или °F·сут.
+func GrowingDegreeDays(days []Day, th Thresholds, cutoff Cutoff, method Method) (DegreeDays, error) {
+	if th.Lower == nil {
+		return DegreeDays{}, fmt.Errorf("%w: %w", ErrInvalidThresholds, ErrNilTemperature)
+	}
+	if cutoff != HorizontalCutoff && cutoff != VerticalCutoff {
+		return DegreeDays{}, fmt.Errorf("%w: %v", ErrUnknownCutoff, cutoff)
+	}
+	if method != MeanTemperature && method != SingleSine {
+		return DegreeDays{}, fmt.Errorf("%w: %v", ErrUnknownMethod, method)
+	}
+	lower := float64(th.Lower.ToKelvin())
+	upper := math.Inf(1)
+	if th.Upper != nil {
+		upper = float64(th.Upper.ToKelvin())
+		if upper <= lower {
+			return DegreeDays{}, fmt.Errorf("%w: верхний порог %v не выше нижнего %v", ErrInvalidThresholds, th.Upper, th.Lower)
+		}
+	}
+
+	var total float64
+	for i, d := range days {
+		if d.Min == nil || d.Max == nil {
+			return DegreeDays{}, fmt.Errorf("%w: сутки %d: %w", ErrInvalidDay, i, ErrNilTemperature)
+		}
+		lo, hi := float64(d.Min.ToKelvin()), float64(d.Max.ToKelvin())
+		if lo > hi {
+			return DegreeDays{}, fmt.Errorf("%w: сутки %d: минимум %v выше максимума %v", ErrInvalidDay, i, d.Min, d.Max)
+		}
+		if method == SingleSine {
+			total += sineGDD(lo, hi, lower, upper, cutoff)
+		} else {
+			total += meanGDD(lo, hi, lower, upper, cutoff)
+		}
+	}
+	return result(total, th.Lower), nil
+}
+
+// CropHeatUnits возвращает сумму тепловых единиц Онтарио (CHU) для кукурузы
+// за последовательность суток days. CHU - безразмерная величина, формула
+// определена для температур в градусах Цельсия.
+func CropHeatUnits(days []Day) (float64, error) {
+	var total float64
+	for i, d := range days {
+		if d.Min == nil || d.Max == nil {
+			return 0, fmt.Errorf("%w: сутки %d: %w", ErrInvalidDay, i, ErrNilTemperature)
+		}
+		lo, hi := float64(d.Min.ToCelsius()), float64(d.Max.ToCelsius())
+		if lo > hi {
+			return 0, fmt.Errorf("%w: сутки %d: минимум %v выше максимума %v", ErrInvalidDay, i, d.Min, d.Max)
+		}
+		var ymax, ymin float64
+		if hi > chuMaxBase {
+			ymax = chuMaxLinear*(hi-chuMaxBase) - chuMaxQuad*(hi-chuMaxBase)*(hi-chuMaxBase)
+		}
+		if lo > chuMinBase {
+			ymin = chuMinLinear * (lo - chuMinBase)
+		}
+		total += (math.Max(ymax, 0) + ymin) / 2
+	}
+	return total, nil
+}
+
+// meanGDD вычисляет суточную сумму эффективных температур по средней
+// температуре.
+func meanGDD(lo, hi, lower, upper float64, cutoff Cutoff) float64 {
+	if cutoff == HorizontalCutoff {
+		lo = math.Min(math.Max(lo, lower), upper)
+		hi = math.Min(math.Max(hi, lower), upper)
+		return (lo+hi)/2 - lower
+	}
+	mean := (lo + hi) / 2
+	if mean > upper {
+		return 0
+	}
+	return math.Max(0, mean-lower)
+}
+
+// sineGDD вычисляет суточную сумму эффективных температур методом одинарной
+// синусоиды с отсечкой по верхнему порогу.
+func sineGDD(lo, hi, lower, upper float64, cutoff Cutoff) float64 {
+	dd := sine(lo, hi, lower, Cooling)
+	if math.IsInf(upper, 1) {
+		return dd
+	}
+	// Горизонтальная отсечка: (T - L)⁺ - (T - U)⁺
+	dd -= sine(lo, hi, upper, Cooling)
+	if cutoff == VerticalCutoff {
+		// Вертикальная отсечка: исключается и оставшаяся часть (U - L) за
+		// время, пока температура выше верхнего порога
+		dd -= (upper - lower) * fractionAbove(lo, hi, upper)
+	}
+	return dd
+}
+
+// fractionAbove возвращает долю суток, в течение которой синусоида между lo
+// и hi находится выше b.
+func fractionAbove(lo, hi, b float64) float64 {
+	switch {
+	case b >= hi:
+		return 0
+	case b <= lo:
+		return 1
+	}
+	mean, amp := (lo+hi)/2, (hi-lo)/2
+	return (math.Pi/2 - math.Asin((b-mean)/amp)) / math.Pi
+}
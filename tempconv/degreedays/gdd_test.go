@@ -0,0 +1,121 @@
+package degreedays
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// fahrenheitDay создает суточные данные в градусах Фаренгейта.
+func fahrenheitDay(lo, hi float64) Day {
+	return Day{Min: tempconv.Fahrenheit(lo), Max: tempconv.Fahrenheit(hi)}
+}
+
+// TestGrowingDegreeDaysMean проверяет модифицированный метод для кукурузы
+// (пороги 50°F и 86°F).
+func TestGrowingDegreeDaysMean(t *testing.T) {
+	th := Thresholds{Lower: tempconv.Fahrenheit(50), Upper: tempconv.Fahrenheit(86)}
+	tests := []struct {
+		day      Day
+		cutoff   Cutoff
+		expected float64
+	}{
+		{fahrenheitDay(60, 80), HorizontalCutoff, 20},
+		{fahrenheitDay(45, 95), HorizontalCutoff, 18}, // (50 + 86)/2 - 50
+		{fahrenheitDay(30, 45), HorizontalCutoff, 0},
+		{fahrenheitDay(45, 95), VerticalCutoff, 20},
+		{fahrenheitDay(88, 100), VerticalCutoff, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v %v", tt.cutoff, tt.day), func(t *testing.T) {
+			got, err := GrowingDegreeDays([]Day{tt.day}, th, tt.cutoff, MeanTemperature)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Scale != tempconv.ScaleFahrenheit {
+				t.Errorf("Scale = %v, want Fahrenheit", got.Scale)
+			}
+			if !almostEqual(got.Value, tt.expected, 1e-9) {
+				t.Errorf("GrowingDegreeDays() = %v, want %v", got.Value, tt.expected)
+			}
+		})
+	}
+}
+
+// TestGrowingDegreeDaysSine проверяет метод одинарной синусоиды с отсечками.
+func TestGrowingDegreeDaysSine(t *testing.T) {
+	th := Thresholds{Lower: tempconv.Celsius(10), Upper: tempconv.Celsius(30)}
+
+	// Суточный ход целиком между порогами: сумма равна средней минус порог
+	inside, _ := GrowingDegreeDays([]Day{celsiusDay(12, 28)}, th, HorizontalCutoff, SingleSine)
+	if !almostEqual(inside.Value, 10, 1e-9) {
+		t.Errorf("inside = %v, want 10", inside.Value)
+	}
+
+	// Суточный ход 10..30 пересекает оба порога на сутках 0..40 (средняя 20,
+	// амплитуда 20). Часть выше 30°C длится 1/3 суток.
+	day := celsiusDay(0, 40)
+	h, _ := GrowingDegreeDays([]Day{day}, th, HorizontalCutoff, SingleSine)
+	v, _ := GrowingDegreeDays([]Day{day}, th, VerticalCutoff, SingleSine)
+	above := func(b float64) float64 {
+		theta := math.Asin((b - 20) / 20)
+		return ((20-b)*(math.Pi/2-theta) + 20*math.Cos(theta)) / math.Pi
+	}
+	wantH := above(10) - above(30)
+	if !almostEqual(h.Value, wantH, 1e-9) {
+		t.Errorf("horizontal = %v, want %v", h.Value, wantH)
+	}
+	if !almostEqual(v.Value, wantH-20.0/3, 1e-9) {
+		t.Errorf("vertical = %v, want %v", v.Value, wantH-20.0/3)
+	}
+
+	// Без верхнего порога результат совпадает с градусо-сутками охлаждения
+	open := Thresholds{Lower: tempconv.Celsius(10)}
+	g, _ := GrowingDegreeDays([]Day{day}, open, VerticalCutoff, SingleSine)
+	cdd, _ := Daily(day, tempconv.Celsius(10), Cooling, SingleSine)
+	if !almostEqual(g.Value, cdd.Value, 1e-9) {
+		t.Errorf("no upper threshold = %v, want %v", g.Value, cdd.Value)
+	}
+}
+
+// TestCropHeatUnits проверяет тепловые единицы Онтарио.
+func TestCropHeatUnits(t *testing.T) {
+	days := []Day{
+		celsiusDay(15, 30), // (33 + 19.008)/2 = 26.004
+		{Min: tempconv.Fahrenheit(32), Max: tempconv.Fahrenheit(50)}, // 0
+	}
+	got, err := CropHeatUnits(days)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !almostEqual(got, 26.004, 1e-9) {
+		t.Errorf("CropHeatUnits() = %v, want 26.004", got)
+	}
+}
+
+// TestGrowingDegreeDaysErrors проверяет обработку некорректных входных данных.
+func TestGrowingDegreeDaysErrors(t *testing.T) {
+	days := []Day{celsiusDay(10, 20)}
+	th := Thresholds{Lower: tempconv.Celsius(10), Upper: tempconv.Celsius(30)}
+
+	if _, err := GrowingDegreeDays(days, Thresholds{}, HorizontalCutoff, MeanTemperature); !errors.Is(err, ErrInvalidThresholds) {
+		t.Errorf("expected error %v, got %v", ErrInvalidThresholds, err)
+	}
+	reversed := Thresholds{Lower: tempconv.Celsius(30), Upper: tempconv.Celsius(10)}
+	if _, err := GrowingDegreeDays(days, reversed, HorizontalCutoff, MeanTemperature); !errors.Is(err, ErrInvalidThresholds) {
+		t.Errorf("expected error %v, got %v", ErrInvalidThresholds, err)
+	}
+	if _, err := GrowingDegreeDays(days, th, Cutoff(4), MeanTemperature); !errors.Is(err, ErrUnknownCutoff) {
+		t.Errorf("expected error %v, got %v", ErrUnknownCutoff, err)
+	}
+	if _, err := GrowingDegreeDays(days, th, HorizontalCutoff, MetOffice); !errors.Is(err, ErrUnknownMethod) {
+		t.Errorf("expected error %v, got %v", ErrUnknownMethod, err)
+	}
+	if _, err := CropHeatUnits([]Day{celsiusDay(20, 10)}); !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("expected error %v, got %v", ErrInvalidDay, err)
+	}
+}
@@ -0,0 +1,75 @@
+package tempconv
+
+import (
+	"fmt"
+	"math"
+)
+
+// Delta - разность температур (температурный интервал) в заданной шкале.
+// В отличие от абсолютной температуры, при переводе разности в другую шкалу
+// учитывается только цена деления шкалы, но не положение нуля: разность
+// 10°C равна 18°F, тогда как температура 10°C равна 50°F.
+type Delta struct {
+	// Value - величина разности в градусах шкалы Scale
+	Value float64
+	// Scale - шкала, в которой задана разность
+	Scale Scale
+}
+
+// Цена деления шкал в кельвинах. Шкала Делисля направлена в обратную
+// сторону, поэтому ее цена деления отрицательна.
+const (
+	fahrenheitDegree = 5.0 / 9.0
+	reaumurDegree    = 5.0 / 4.0
+	delisleDegree    = -2.0 / 3.0
+	newtonDegree     = 100.0 / 33.0
+)
+
+// NewDelta создает разность температур величиной v в шкале s.
+func NewDelta(v float64, s Scale) (Delta, error) {
+	if !s.Valid() {
+		return Delta{}, fmt.Errorf("%w: %v", ErrUnknownScale, s)
+	}
+	return Delta{Value: v, Scale: s}, nil
+}
+
+// DeltaBetween возвращает разность температур a - b в шкале s.
+func DeltaBetween(a, b Temperature, s Scale) Delta {
+	return Delta{Value: float64(a.ToKelvin()-b.ToKelvin()) / s.degree(), Scale: s}
+}
+
+// Kelvins возвращает величину разности в кельвинах.
+func (d Delta) Kelvins() float64 { return d.Value * d.Scale.degree() }
+
+// In возвращает ту же разность температур в шкале s.
+func (d Delta) In(s Scale) Delta { return Delta{Value: d.Kelvins() / s.degree(), Scale: s} }
+
+// Add прибавляет разность d к температуре t. Результат возвращается в шкале t.
+func (d Delta) Add(t Temperature) Temperature {
+	k := Kelvin(float64(t.ToKelvin()) + d.Kelvins())
+	if s := ScaleOf(t); s.Valid() {
+		return s.Convert(k)
+	}
+	return k
+}
+
+// String возвращает строковое представление разности, например "Δ5.00°C".
+func (d Delta) String() string { return fmt.Sprintf("Δ%.2f%s", d.Value, d.Scale.Symbol()) }
+
+// degree возвращает цену деления шкалы в кельвинах. Для недопустимой шкалы
+// возвращается NaN, чтобы ошибка не осталась незамеченной в расчетах.
+func (s Scale) degree() float64 {
+	switch s {
+	case ScaleCelsius, ScaleKelvin:
+		return 1
+	case ScaleFahrenheit, ScaleRankine:
+		return fahrenheitDegree
+	case ScaleReaumur:
+		return reaumurDegree
+	case ScaleDelisle:
+		return delisleDegree
+	case ScaleNewton:
+		return newtonDegree
+	}
+	return math.NaN()
+}
@@ -0,0 +1,67 @@
+package tempconv
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// TestDeltaIn проверяет перевод разности температур между шкалами без учета
+// смещения нуля.
+func TestDeltaIn(t *testing.T) {
+	tests := []struct {
+		scale    Scale
+		expected float64
+	}{
+		{ScaleCelsius, 10},
+		{ScaleKelvin, 10},
+		{ScaleFahrenheit, 18},
+		{ScaleRankine, 18},
+		{ScaleReaumur, 8},
+		{ScaleDelisle, -15},
+		{ScaleNewton, 3.3},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("Delta %v", tt.scale), func(t *testing.T) {
+			d := Delta{Value: 10, Scale: ScaleCelsius}.In(tt.scale)
+			if !almostEqual(d.Value, tt.expected, 1e-9) {
+				t.Errorf("In(%v) = %v, want %v", tt.scale, d.Value, tt.expected)
+			}
+			if !almostEqual(d.Kelvins(), 10, 1e-9) {
+				t.Errorf("Kelvins() = %v, want 10", d.Kelvins())
+			}
+		})
+	}
+}
+
+// TestDeltaBetween проверяет вычисление разности температур, заданных в
+// разных шкалах, и прибавление разности к температуре.
+func TestDeltaBetween(t *testing.T) {
+	d := DeltaBetween(Fahrenheit(212), Celsius(0), ScaleFahrenheit)
+	if !almostEqual(d.Value, 180, 1e-9) {
+		t.Errorf("DeltaBetween() = %v, want 180", d.Value)
+	}
+	if got := d.String(); got != "Δ180.00°F" {
+		t.Errorf("String() = %v, want Δ180.00°F", got)
+	}
+
+	sum := d.Add(Celsius(20))
+	if _, ok := sum.(Celsius); !ok {
+		t.Fatalf("Add() returned %T, want Celsius", sum)
+	}
+	if !almostEqual(float64(sum.(Celsius)), 120, 1e-9) {
+		t.Errorf("Add() = %v, want 120°C", sum)
+	}
+}
+
+// TestNewDelta проверяет создание разности в недопустимой шкале.
+func TestNewDelta(t *testing.T) {
+	if _, err := NewDelta(1, 0); !errors.Is(err, ErrUnknownScale) {
+		t.Errorf("expected error %v, got %v", ErrUnknownScale, err)
+	}
+	if d := (Delta{Value: 1}); !math.IsNaN(d.Kelvins()) {
+		t.Errorf("Kelvins() of invalid scale = %v, want NaN", d.Kelvins())
+	}
+}
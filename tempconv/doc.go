@@ -72,6 +72,55 @@
 //
 // Эти функции возвращают ошибку, если указанное значение температуры меньше абсолютного нуля.
 //
+// # Шкалы и разности температур:
+//
+// Тип Scale идентифицирует шкалу (ScaleCelsius, ScaleKelvin и т.д.) и позволяет
+// работать со шкалой, выбранной во время выполнения: ParseScale разбирает
+// обозначение шкалы, Scale.New создает температуру с проверкой абсолютного нуля,
+// Scale.Convert преобразует температуру в шкалу, ScaleOf и ValueOf возвращают
+// шкалу и числовое значение температуры.
+//
+// Тип Delta описывает разность температур. При переводе разности между шкалами
+// учитывается только цена деления: Δ10°C равна Δ18°F, тогда как 10°C равна 50°F.
+//
+// # Базы данных:
+//
+// Все типы температур реализуют sql.Scanner и driver.Valuer и хранятся как числа.
+// При чтении и записи значения проверяются конструкторами New*. Для столбцов,
+// допускающих NULL, предназначены типы NullCelsius, NullFahrenheit, NullKelvin и т.д.
+//
+// Тип AnyTemperature хранит температуру вместе со шкалой, в которой она была введена:
+// в одном текстовом столбце ("25 C") или в двух столбцах (число и Scale). Текстовое
+// представление создает FormatTemperature и разбирает ParseTemperature.
+//
+// # Двоичные представления:
+//
+// Все типы температур реализуют MarshalBinary/UnmarshalBinary и
+// MarshalCBOR/UnmarshalCBOR. Компактное представление состоит из байта шкалы и
+// значения в виде float64, float32 или фиксированной точки (AppendBinary,
+// AppendCBOR, DecodeBinary, DecodeCBOR); при разборе значение проверяется на
+// абсолютный ноль и преобразуется в шкалу получателя. Это представление
+// использует и encoding/gob, поэтому потоки gob, в которых температуры были
+// записаны как float64, в типы температур не разбираются.
+//
+// # Файлы конфигурации:
+//
+// Все типы температур и AnyTemperature реализуют UnmarshalText, UnmarshalJSON,
+// UnmarshalYAML и UnmarshalTOML и принимают как числа без обозначения шкалы, так и
+// строки вида "85°C". Значение проверяется на абсолютный ноль при загрузке.
+// Шкалу для чисел без обозначения шкалы в AnyTemperature задает поле
+// DefaultScale; само поле не записывается, а AnyTemperature записывается
+// строкой со шкалой, которая разбирается одинаково при любом DefaultScale.
+// MarshalJSON записывает температуру числом, поэтому значения разбираются
+// обратно без изменений; NaN и бесконечности отклоняются с ErrEncodingRange.
+//
+// # Флаги командной строки:
+//
+// Указатели на все типы температур и на AnyTemperature реализуют flag.Value, а
+// FlagVar и FlagSetVar определяют флаг со значением по умолчанию:
+// "-setpoint=72F". Число без обозначения шкалы разбирает ParseTemperatureIn в
+// шкале типа. Загрузку из переменных окружения выполняет пакет tempconv/env.
+//
 // # Пример использования:
 //
 //	package main
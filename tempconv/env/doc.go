@@ -0,0 +1,25 @@
+// Пакет env загружает температуры из переменных окружения.
+//
+// Значение переменной разбирается так же, как в файлах конфигурации и флагах
+// командной строки: строка с обозначением шкалы ("72F", "85 °C", "300 K") или
+// число без шкалы, которое считается заданным в шкале по умолчанию. Значения
+// ниже абсолютного нуля отклоняются с ошибкой tempconv.ErrBelowAbsoluteZero,
+// а в тексте ошибки указывается имя переменной.
+//
+// # Пример использования:
+//
+//	// SETPOINT=72F
+//	setpoint, err := env.Get("SETPOINT", tempconv.Celsius(20))
+//	if err != nil {
+//	    fmt.Println("Ошибка:", err)
+//	    return
+//	}
+//	fmt.Println(setpoint.ToCelsius()) // 22.22°C
+//
+//	// MAX_TEMP=85
+//	var maxTemp tempconv.Celsius = 90
+//	if err := env.Var(&maxTemp, "MAX_TEMP"); err != nil {
+//	    fmt.Println("Ошибка:", err)
+//	    return
+//	}
+package env
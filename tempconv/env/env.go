@@ -0,0 +1,97 @@
+package env
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// Ошибки загрузки из окружения
+var (
+	ErrNotSet = errors.New("переменная окружения не задана")
+)
+
+// Lookup возвращает температуру из переменной окружения key. Число без
+// обозначения шкалы считается заданным в шкале def. Если переменная не задана
+// или пуста, возвращается false и нулевая ошибка.
+func Lookup(key string, def tempconv.Scale) (tempconv.Temperature, bool, error) {
+	s, ok := lookup(key)
+	if !ok {
+		return nil, false, nil
+	}
+	t, err := tempconv.ParseTemperatureIn(s, def)
+	if err != nil {
+		return nil, true, wrap(key, err)
+	}
+	return t, true, nil
+}
+
+// Get возвращает температуру из переменной окружения key или fallback, если
+// переменная не задана. Число без обозначения шкалы считается заданным в шкале
+// fallback, а при fallback, равном nil, - в шкале Цельсия.
+func Get(key string, fallback tempconv.Temperature) (tempconv.Temperature, error) {
+	def := tempconv.ScaleCelsius
+	if fallback != nil {
+		def = tempconv.ScaleOf(fallback)
+	}
+	t, ok, err := Lookup(key, def)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return fallback, nil
+	}
+	return t, nil
+}
+
+// Require возвращает температуру из переменной окружения key так же, как
+// Lookup, но возвращает ошибку ErrNotSet, если переменная не задана.
+func Require(key string, def tempconv.Scale) (tempconv.Temperature, error) {
+	t, ok, err := Lookup(key, def)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, wrap(key, ErrNotSet)
+	}
+	return t, nil
+}
+
+// Var записывает в p значение переменной окружения key, разобранное методом
+// UnmarshalText. Для типов температур (tempconv.Celsius, tempconv.Kelvin и
+// т.д.) значение преобразуется в шкалу p, число без обозначения шкалы
+// считается заданным в шкале p, а для tempconv.AnyTemperature - в шкале поля
+// DefaultScale. Если переменная не задана или разобрать ее не удалось, p не
+// изменяется.
+func Var[T any, P interface {
+	*T
+	encoding.TextUnmarshaler
+}](p *T, key string) error {
+	s, ok := lookup(key)
+	if !ok {
+		return nil
+	}
+	v := *p
+	if err := P(&v).UnmarshalText([]byte(s)); err != nil {
+		return wrap(key, err)
+	}
+	*p = v
+	return nil
+}
+
+// lookup возвращает значение переменной окружения без окружающих пробелов.
+// Пустое значение считается незаданным.
+func lookup(key string) (string, bool) {
+	s, ok := os.LookupEnv(key)
+	s = strings.TrimSpace(s)
+	return s, ok && s != ""
+}
+
+// wrap добавляет к ошибке имя переменной окружения.
+func wrap(key string, err error) error {
+	return fmt.Errorf("переменная окружения %s: %w", key, err)
+}
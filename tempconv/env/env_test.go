@@ -0,0 +1,120 @@
+package env
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// almostEqual проверяет, что два числа почти равны с заданной погрешностью.
+func almostEqual(a, b float64, epsilon float64) bool {
+	return math.Abs(a-b) <= epsilon
+}
+
+// TestGet проверяет загрузку температуры с обозначением шкалы и без него.
+func TestGet(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		fallback tempconv.Temperature
+		expected tempconv.Temperature
+		err      error
+	}{
+		{"шкала из значения", "72F", tempconv.Celsius(20), tempconv.Fahrenheit(72), nil},
+		{"шкала по умолчанию", "300", tempconv.Kelvin(0), tempconv.Kelvin(300), nil},
+		{"без fallback", "25", nil, tempconv.Celsius(25), nil},
+		{"пробелы", " 85 °C ", nil, tempconv.Celsius(85), nil},
+		{"не задана", "", tempconv.Celsius(20), tempconv.Celsius(20), nil},
+		{"ниже абсолютного нуля", "-10", tempconv.Kelvin(0), nil, tempconv.ErrBelowAbsoluteZero},
+		{"неизвестная шкала", "25 X", nil, nil, tempconv.ErrUnknownScale},
+		{"не число", "warm", nil, nil, tempconv.ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("SETPOINT", tt.value)
+			got, err := Get("SETPOINT", tt.fallback)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if got != tt.expected {
+				t.Errorf("Get() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestLookup проверяет различие между незаданной и заданной переменной.
+func TestLookup(t *testing.T) {
+	if _, ok, err := Lookup("TEMPCONV_UNSET_VARIABLE", tempconv.ScaleCelsius); ok || err != nil {
+		t.Errorf("Lookup() = %v, %v, want false, nil", ok, err)
+	}
+
+	t.Setenv("SETPOINT", "0 K")
+	got, ok, err := Lookup("SETPOINT", tempconv.ScaleCelsius)
+	if !ok || err != nil || got != tempconv.Kelvin(0) {
+		t.Errorf("Lookup() = %v, %v, %v, want 0K, true, nil", got, ok, err)
+	}
+}
+
+// TestRequire проверяет ошибку для незаданной переменной.
+func TestRequire(t *testing.T) {
+	_, err := Require("TEMPCONV_UNSET_VARIABLE", tempconv.ScaleCelsius)
+	if !errors.Is(err, ErrNotSet) {
+		t.Errorf("expected error %v, got %v", ErrNotSet, err)
+	}
+
+	t.Setenv("MAX_TEMP", "-500F")
+	_, err = Require("MAX_TEMP", tempconv.ScaleCelsius)
+	if !errors.Is(err, tempconv.ErrBelowAbsoluteZero) {
+		t.Fatalf("expected error %v, got %v", tempconv.ErrBelowAbsoluteZero, err)
+	}
+	if want := "переменная окружения MAX_TEMP: "; !strings.HasPrefix(err.Error(), want) {
+		t.Errorf("error = %q, want prefix %q", err, want)
+	}
+}
+
+// TestVar проверяет загрузку в переменную конкретного типа.
+func TestVar(t *testing.T) {
+	t.Setenv("SETPOINT", "212F")
+	t.Setenv("LIMIT", "400")
+
+	var setpoint tempconv.Celsius = 20
+	if err := Var(&setpoint, "SETPOINT"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !almostEqual(float64(setpoint), 100, 1e-9) {
+		t.Errorf("setpoint = %v, want 100°C", setpoint)
+	}
+
+	var limit tempconv.Kelvin
+	if err := Var(&limit, "LIMIT"); err != nil || limit != 400 {
+		t.Errorf("limit = %v, %v, want 400K", limit, err)
+	}
+
+	var target tempconv.AnyTemperature
+	if err := Var(&target, "SETPOINT"); err != nil || target.Temperature != tempconv.Fahrenheit(212) {
+		t.Errorf("target = %v, %v, want 212°F", target, err)
+	}
+
+	t.Setenv("TARGET", "72")
+	target = tempconv.AnyTemperature{DefaultScale: tempconv.ScaleFahrenheit}
+	if err := Var(&target, "TARGET"); err != nil || target.Temperature != tempconv.Fahrenheit(72) {
+		t.Errorf("target = %v, %v, want 72°F", target, err)
+	}
+
+	// Незаданная переменная не изменяет значение по умолчанию
+	def := tempconv.Celsius(5)
+	if err := Var(&def, "TEMPCONV_UNSET_VARIABLE"); err != nil || def != 5 {
+		t.Errorf("def = %v, %v, want 5°C", def, err)
+	}
+
+	// Ошибка не изменяет значение
+	t.Setenv("LIMIT", "-1")
+	if err := Var(&limit, "LIMIT"); !errors.Is(err, tempconv.ErrBelowAbsoluteZero) || limit != 400 {
+		t.Errorf("limit = %v, %v, want 400K and %v", limit, err, tempconv.ErrBelowAbsoluteZero)
+	}
+}
@@ -0,0 +1,46 @@
+// Пакет expr вычисляет выражения с температурами, например
+// "(72F - 20C) in K", "avg(20C, 300K, 70F)" или "25C + 5 deltaC".
+//
+// Выражение состоит из чисел, температур, разностей температур, операторов
+// + - * /, скобок, функций и преобразования "in". Температура записывается как
+// число с обозначением шкалы ("25C", "98.6 °F", "300 kelvin"), разность - как
+// число с обозначением шкалы и приставкой delta или Δ ("5 deltaC", "9 ΔF",
+// "Δ5°C"). Обозначения шкал разбирает tempconv.ParseScale.
+//
+// Вычисление учитывает различие между абсолютной температурой и разностью
+// температур (tempconv.Delta):
+//
+//   - температура - температура = разность в шкале левого операнда;
+//   - температура ± разность = температура в шкале температуры;
+//   - разность ± разность = разность в шкале левого операнда;
+//   - разность * число и разность / число = разность;
+//   - разность / разность = число;
+//   - сумма двух температур, произведение температур и операции
+//     температур с числами без шкалы недопустимы.
+//
+// Оператор "in" имеет наименьший приоритет и переводит температуру или
+// разность в другую шкалу: "(72F - 20C) in K" дает разность 2.22 K, а не
+// температуру.
+//
+// Функции avg, min и max принимают значения одного вида (температуры,
+// разности или числа в любых шкалах) и возвращают результат в шкале первого
+// аргумента для avg и исходное значение для min и max. Функция abs принимает
+// разность или число.
+//
+// Температуры проверяются на абсолютный ноль как при разборе, так и после
+// каждой операции; результат, который не представим конечным числом
+// (переполнение, Inf-Inf), отклоняется с ошибкой ErrOutOfRange. Выражение не может вызывать произвольный код, а глубина
+// вложенности ограничена, поэтому Eval подходит для формул, введенных
+// пользователем.
+//
+// # Пример использования:
+//
+//	v, err := expr.Eval("(72F - 20C) in K")
+//	if err != nil {
+//	    fmt.Println("Ошибка:", err)
+//	    return
+//	}
+//	if v.Kind == expr.KindDelta {
+//	    fmt.Println(v.Delta) // Δ2.22K
+//	}
+package expr
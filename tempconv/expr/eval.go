@@ -0,0 +1,318 @@
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// Ошибки вычисления выражений
+var (
+	ErrSyntax           = errors.New("синтаксическая ошибка")
+	ErrInvalidOperation = errors.New("недопустимая операция")
+	ErrDivisionByZero   = errors.New("деление на ноль")
+	ErrUnknownFunction  = errors.New("неизвестная функция")
+	ErrOutOfRange       = errors.New("результат вне диапазона чисел float64")
+)
+
+const (
+	// maxLength - максимальная длина выражения в байтах
+	maxLength = 4096
+	// maxDepth - максимальная глубина вложенности скобок, функций и
+	// унарных операторов
+	maxDepth = 64
+)
+
+// Eval вычисляет выражение s и возвращает результат: число, температуру или
+// разность температур. Синтаксические ошибки возвращаются с ошибкой ErrSyntax
+// и позицией в выражении, неизвестные шкалы - с ошибкой
+// tempconv.ErrUnknownScale, температуры ниже абсолютного нуля - с ошибкой
+// tempconv.ErrBelowAbsoluteZero.
+func Eval(s string) (Value, error) {
+	if len(s) > maxLength {
+		return Value{}, fmt.Errorf("%w: выражение длиннее %d байт", ErrSyntax, maxLength)
+	}
+	tokens, err := lex(s)
+	if err != nil {
+		return Value{}, err
+	}
+	p := &parser{tokens: tokens}
+	v, err := p.conversion()
+	if err != nil {
+		return Value{}, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return Value{}, p.unexpected(t)
+	}
+	return v, nil
+}
+
+// parser вычисляет выражение методом рекурсивного спуска:
+//
+//	conversion = sum { "in" unit }
+//	sum        = product { ("+" | "-") product }
+//	product    = unary { ("*" | "/") unary }
+//	unary      = ("+" | "-") unary | primary
+//	primary    = number [ unit ] | delta number unit | "(" conversion ")"
+//	           | name "(" conversion { "," conversion } ")"
+type parser struct {
+	tokens []token
+	pos    int
+	depth  int
+}
+
+// peek возвращает текущую лексему.
+func (p *parser) peek() token { return p.tokens[p.pos] }
+
+// next возвращает текущую лексему и переходит к следующей.
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+// isOperator сообщает, является ли текущая лексема оператором op.
+func (p *parser) isOperator(op string) bool {
+	t := p.peek()
+	return t.kind == tokenOperator && t.text == op
+}
+
+// expect пропускает оператор op или возвращает синтаксическую ошибку.
+func (p *parser) expect(op string) error {
+	if !p.isOperator(op) {
+		return fmt.Errorf("%w: позиция %d: ожидается %q, получено %v", ErrSyntax, p.peek().pos, op, p.peek())
+	}
+	p.pos++
+	return nil
+}
+
+// unexpected возвращает ошибку неожиданной лексемы.
+func (p *parser) unexpected(t token) error {
+	return fmt.Errorf("%w: позиция %d: неожиданно %v", ErrSyntax, t.pos, t)
+}
+
+// enter увеличивает глубину вложенности и проверяет ограничение.
+func (p *parser) enter() error {
+	p.depth++
+	if p.depth > maxDepth {
+		return fmt.Errorf("%w: позиция %d: вложенность глубже %d", ErrSyntax, p.peek().pos, maxDepth)
+	}
+	return nil
+}
+
+// conversion разбирает выражение с необязательным переводом в шкалу.
+func (p *parser) conversion() (Value, error) {
+	v, err := p.sum()
+	if err != nil {
+		return Value{}, err
+	}
+	for t := p.peek(); t.kind == tokenIdent && strings.EqualFold(t.text, "in"); t = p.peek() {
+		p.next()
+		s, delta, err := p.unit()
+		if err != nil {
+			return Value{}, err
+		}
+		if delta && v.Kind != KindDelta {
+			return Value{}, fmt.Errorf("%w: %v нельзя перевести в разность, вычтите температуры", ErrInvalidOperation, v.Kind)
+		}
+		if v, err = v.In(s); err != nil {
+			return Value{}, err
+		}
+	}
+	return v, nil
+}
+
+// sum разбирает сложение и вычитание.
+func (p *parser) sum() (Value, error) {
+	v, err := p.product()
+	if err != nil {
+		return Value{}, err
+	}
+	for p.isOperator("+") || p.isOperator("-") {
+		op := p.next().text
+		w, err := p.product()
+		if err != nil {
+			return Value{}, err
+		}
+		if op == "+" {
+			v, err = add(v, w)
+		} else {
+			v, err = sub(v, w)
+		}
+		if err != nil {
+			return Value{}, err
+		}
+	}
+	return v, nil
+}
+
+// product разбирает умножение и деление.
+func (p *parser) product() (Value, error) {
+	v, err := p.unary()
+	if err != nil {
+		return Value{}, err
+	}
+	for p.isOperator("*") || p.isOperator("/") {
+		op := p.next().text
+		w, err := p.unary()
+		if err != nil {
+			return Value{}, err
+		}
+		if op == "*" {
+			v, err = mul(v, w)
+		} else {
+			v, err = div(v, w)
+		}
+		if err != nil {
+			return Value{}, err
+		}
+	}
+	return v, nil
+}
+
+// unary разбирает унарные плюс и минус. Знак перед числом с обозначением
+// шкалы относится к числу, поэтому "-40F" - это температура -40°F.
+func (p *parser) unary() (Value, error) {
+	if err := p.enter(); err != nil {
+		return Value{}, err
+	}
+	defer func() { p.depth-- }()
+
+	if !p.isOperator("-") && !p.isOperator("+") {
+		return p.primary(1)
+	}
+	op := p.next().text
+	if p.peek().kind == tokenNumber {
+		sign := 1.0
+		if op == "-" {
+			sign = -1
+		}
+		return p.primary(sign)
+	}
+	v, err := p.unary()
+	if err != nil || op == "+" {
+		return v, err
+	}
+	return neg(v)
+}
+
+// primary разбирает число, температуру, разность, выражение в скобках или
+// вызов функции. Знак sign применяется к числу в начале лексемы.
+func (p *parser) primary(sign float64) (Value, error) {
+	t := p.next()
+	switch {
+	case t.kind == tokenNumber:
+		return p.literal(sign*t.num, false)
+	case t.kind == tokenIdent && isDeltaPrefix(t.text) && p.peek().kind == tokenNumber:
+		// Разность в форме Delta.String: "Δ5°C"
+		return p.literal(sign*p.next().num, true)
+	case t.kind == tokenOperator && t.text == "(":
+		v, err := p.conversion()
+		if err != nil {
+			return Value{}, err
+		}
+		return v, p.expect(")")
+	case t.kind == tokenIdent && p.isOperator("("):
+		return p.call(t)
+	}
+	return Value{}, p.unexpected(t)
+}
+
+// literal разбирает обозначение шкалы после числа v. Число без шкалы
+// возвращается как KindNumber. Если delta истинно, шкала обязательна, а
+// результатом является разность.
+func (p *parser) literal(v float64, delta bool) (Value, error) {
+	if t := p.peek(); t.kind != tokenIdent || strings.EqualFold(t.text, "in") {
+		if delta {
+			return Value{}, fmt.Errorf("%w: позиция %d: ожидается шкала разности", ErrSyntax, t.pos)
+		}
+		return Number(v), nil
+	}
+	s, d, err := p.unit()
+	if err != nil {
+		return Value{}, err
+	}
+	if delta && d {
+		return Value{}, p.unexpected(p.tokens[p.pos-1])
+	}
+	if delta || d {
+		return Delta(tempconv.Delta{Value: v, Scale: s}), nil
+	}
+	t, err := s.New(v)
+	if err != nil {
+		return Value{}, err
+	}
+	return Temperature(t), nil
+}
+
+// unit разбирает обозначение шкалы, возможно с приставкой разности: "C",
+// "°F", "kelvin", "deltaC", "ΔF", "delta C".
+func (p *parser) unit() (tempconv.Scale, bool, error) {
+	t := p.next()
+	if t.kind != tokenIdent {
+		return 0, false, fmt.Errorf("%w: позиция %d: ожидается шкала, получено %v", ErrSyntax, t.pos, t)
+	}
+	name, delta := trimDeltaPrefix(t.text)
+	if delta && name == "" {
+		if t = p.next(); t.kind != tokenIdent {
+			return 0, false, fmt.Errorf("%w: позиция %d: ожидается шкала, получено %v", ErrSyntax, t.pos, t)
+		}
+		name = t.text
+	}
+	s, err := tempconv.ParseScale(name)
+	if err != nil {
+		return 0, false, fmt.Errorf("%w (позиция %d)", err, t.pos)
+	}
+	return s, delta, nil
+}
+
+// call вычисляет вызов функции name с аргументами в скобках.
+func (p *parser) call(name token) (Value, error) {
+	fn, ok := functions[strings.ToLower(name.text)]
+	if !ok {
+		return Value{}, fmt.Errorf("%w: позиция %d: %q", ErrUnknownFunction, name.pos, name.text)
+	}
+	p.next() // "("
+	var args []Value
+	for !p.isOperator(")") {
+		if len(args) > 0 {
+			if err := p.expect(","); err != nil {
+				return Value{}, err
+			}
+		}
+		v, err := p.conversion()
+		if err != nil {
+			return Value{}, err
+		}
+		args = append(args, v)
+	}
+	p.next() // ")"
+	v, err := fn(args)
+	if err != nil {
+		return Value{}, fmt.Errorf("%s: %w", strings.ToLower(name.text), err)
+	}
+	return v, nil
+}
+
+// deltaPrefixes - приставки, обозначающие разность температур
+var deltaPrefixes = []string{"delta", "Δ"}
+
+// trimDeltaPrefix отделяет приставку разности от обозначения шкалы.
+func trimDeltaPrefix(s string) (string, bool) {
+	for _, prefix := range deltaPrefixes {
+		if len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix) {
+			return s[len(prefix):], true
+		}
+	}
+	return s, false
+}
+
+// isDeltaPrefix сообщает, является ли s приставкой разности без шкалы.
+func isDeltaPrefix(s string) bool {
+	name, delta := trimDeltaPrefix(s)
+	return delta && name == ""
+}
@@ -0,0 +1,116 @@
+package expr
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// TestEval проверяет вычисление выражений и вид результата.
+func TestEval(t *testing.T) {
+	tests := []struct {
+		input string
+		kind  Kind
+		value float64
+		scale tempconv.Scale
+	}{
+		// Разность температур в кельвинах, а не температура
+		{"(72F - 20C) in K", KindDelta, 20.0 / 9, tempconv.ScaleKelvin},
+		{"avg(20C, 300K, 70F)", KindTemperature, (20 + 26.85 + 21.111111111111111) / 3, tempconv.ScaleCelsius},
+		{"25C + 5 deltaC", KindTemperature, 30, tempconv.ScaleCelsius},
+		{"25C + 9 ΔF", KindTemperature, 30, tempconv.ScaleCelsius},
+		{"5 deltaC + 25C", KindTemperature, 30, tempconv.ScaleCelsius},
+		{"25°C - 9 delta F in F", KindTemperature, 68, tempconv.ScaleFahrenheit},
+		{"Δ5°C in F", KindDelta, 9, tempconv.ScaleFahrenheit},
+		{"100C in F", KindTemperature, 212, tempconv.ScaleFahrenheit},
+		{"-40F in C", KindTemperature, -40, tempconv.ScaleCelsius},
+		{"0 kelvin in Celsius", KindTemperature, -273.15, tempconv.ScaleCelsius},
+		{"(100C - 0C) / 2 in F", KindDelta, 90, tempconv.ScaleFahrenheit},
+		{"(30C - 20C) / (2 deltaC)", KindNumber, 5, 0},
+		{"-(5 deltaC) * 2", KindDelta, -10, tempconv.ScaleCelsius},
+		{"2 * (3 + 4) - 1", KindNumber, 13, 0},
+		{"-2 * -3", KindNumber, 6, 0},
+		{"min(300K, 20C, 70F)", KindTemperature, 20, tempconv.ScaleCelsius},
+		{"max(0C, 0De)", KindTemperature, 0, tempconv.ScaleDelisle},
+		{"avg(1 deltaC, 9 deltaF) in K", KindDelta, 3, tempconv.ScaleKelvin},
+		{"abs(20C - 30C)", KindDelta, 10, tempconv.ScaleCelsius},
+		{"AVG(1, 2, 3)", KindNumber, 2, 0},
+		{"1e2 K in C", KindTemperature, -173.15, tempconv.ScaleCelsius},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Eval(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Kind != tt.kind || got.Scale() != tt.scale || !almostEqual(got.Float(), tt.value, 1e-9) {
+				t.Errorf("Eval(%q) = %v (%v, %v), want %v %v in %v", tt.input, got, got.Kind, got.Scale(), tt.kind, tt.value, tt.scale)
+			}
+		})
+	}
+}
+
+// TestEvalErrors проверяет обработку ошибок.
+func TestEvalErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		err   error
+	}{
+		{"25C + 25C", ErrInvalidOperation},
+		{"25C + 5", ErrInvalidOperation},
+		{"25C * 2", ErrInvalidOperation},
+		{"-(25C)", ErrInvalidOperation},
+		{"1 in K", ErrInvalidOperation},
+		{"20C in deltaK", ErrInvalidOperation},
+		{"avg(20C, 5 deltaC)", ErrInvalidOperation},
+		{"avg()", ErrInvalidOperation},
+		{"abs(20C)", ErrInvalidOperation},
+		{"1 / (5C - 5C)", ErrDivisionByZero},
+		{"0K - 1 deltaK", tempconv.ErrBelowAbsoluteZero},
+		{"-500F", tempconv.ErrBelowAbsoluteZero},
+		{"25 X", tempconv.ErrUnknownScale},
+		{"25C in X", tempconv.ErrUnknownScale},
+		{"exec(1)", ErrUnknownFunction},
+		{"1e308*10", ErrOutOfRange},
+		{"1e308C in F", ErrOutOfRange},
+		{"(1e308*10-1e308*10)", ErrOutOfRange},
+		{"1e308 deltaC * 10", ErrOutOfRange},
+		{"1e308 deltaC in F", ErrOutOfRange},
+		{"avg(1e308, 1e308)", ErrOutOfRange},
+		{"-1e308 - 1e308", ErrOutOfRange},
+		{"1 / 1e-320 / 1e-10", ErrOutOfRange},
+		{"", ErrSyntax},
+		{"(1 + 2", ErrSyntax},
+		{"1 + 2)", ErrSyntax},
+		{"avg(1,)", ErrSyntax},
+		{"Δ5", ErrSyntax},
+		{"25C in", ErrSyntax},
+		{"os", ErrSyntax},
+		{strings.Repeat("(", 100) + "1" + strings.Repeat(")", 100), ErrSyntax},
+		{strings.Repeat("1+", 3000) + "1", ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		name := tt.input
+		if len(name) > 20 {
+			name = name[:20]
+		}
+		t.Run(name, func(t *testing.T) {
+			if _, err := Eval(tt.input); !errors.Is(err, tt.err) {
+				t.Errorf("Eval(%q) error = %v, want %v", tt.input, err, tt.err)
+			}
+		})
+	}
+}
+
+// TestEvalErrorPosition проверяет, что синтаксическая ошибка указывает
+// позицию в выражении.
+func TestEvalErrorPosition(t *testing.T) {
+	_, err := Eval("25C + * 2")
+	if err == nil || !strings.Contains(err.Error(), "позиция 6") {
+		t.Errorf("error = %v, want position 6", err)
+	}
+}
@@ -0,0 +1,86 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// function - встроенная функция выражений.
+type function func(args []Value) (Value, error)
+
+// functions - встроенные функции по именам
+var functions = map[string]function{
+	"avg": avg,
+	"min": func(args []Value) (Value, error) { return extremum(args, -1) },
+	"max": func(args []Value) (Value, error) { return extremum(args, 1) },
+	"abs": abs,
+}
+
+// avg возвращает среднее значение аргументов одного вида в шкале первого
+// аргумента. Температуры усредняются в кельвинах, поэтому шкалы аргументов
+// могут различаться.
+func avg(args []Value) (Value, error) {
+	if err := sameKind(args); err != nil {
+		return Value{}, err
+	}
+	var sum float64
+	for _, v := range args {
+		sum += v.kelvins()
+	}
+	mean := sum / float64(len(args))
+
+	first := args[0]
+	switch first.Kind {
+	case KindTemperature:
+		return checked(first.Scale().Convert(tempconv.Kelvin(mean)))
+	case KindDelta:
+		return finite(Delta(tempconv.Delta{Value: mean, Scale: tempconv.ScaleKelvin}.In(first.Delta.Scale)))
+	}
+	return finite(Number(mean))
+}
+
+// extremum возвращает аргумент с наименьшим (sign < 0) или наибольшим
+// (sign > 0) значением. Температуры и разности сравниваются в кельвинах, а
+// результат возвращается в собственной шкале, поэтому max(0C, 0De) дает 0°De.
+func extremum(args []Value, sign float64) (Value, error) {
+	if err := sameKind(args); err != nil {
+		return Value{}, err
+	}
+	best := args[0]
+	for _, v := range args[1:] {
+		if sign*(v.kelvins()-best.kelvins()) > 0 {
+			best = v
+		}
+	}
+	return best, nil
+}
+
+// abs возвращает абсолютную величину числа или разности.
+func abs(args []Value) (Value, error) {
+	if len(args) != 1 {
+		return Value{}, fmt.Errorf("%w: ожидается 1 аргумент, получено %d", ErrInvalidOperation, len(args))
+	}
+	v := args[0]
+	switch v.Kind {
+	case KindNumber:
+		return finite(Number(math.Abs(v.Number)))
+	case KindDelta:
+		return finite(Delta(tempconv.Delta{Value: math.Abs(v.Delta.Value), Scale: v.Delta.Scale}))
+	}
+	return Value{}, fmt.Errorf("%w: абсолютная величина температуры", ErrInvalidOperation)
+}
+
+// sameKind проверяет, что аргументов не меньше одного и все они одного вида.
+func sameKind(args []Value) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: нет аргументов", ErrInvalidOperation)
+	}
+	for _, v := range args[1:] {
+		if v.Kind != args[0].Kind {
+			return fmt.Errorf("%w: аргументы разных видов (%v и %v)", ErrInvalidOperation, args[0].Kind, v.Kind)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package expr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// tokenKind - вид лексемы.
+type tokenKind int
+
+// Виды лексем
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenIdent
+	tokenOperator
+)
+
+// token - лексема выражения.
+type token struct {
+	kind tokenKind
+	// text - исходный текст лексемы
+	text string
+	// num - значение числа для tokenNumber
+	num float64
+	// pos - позиция лексемы в выражении (в байтах)
+	pos int
+}
+
+// String возвращает описание лексемы для сообщений об ошибках.
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "конец выражения"
+	}
+	return strconv.Quote(t.text)
+}
+
+// lex разбивает выражение на лексемы. Последней лексемой всегда является
+// tokenEOF.
+func lex(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			return nil, fmt.Errorf("%w: позиция %d: недопустимая кодировка UTF-8", ErrSyntax, i)
+		case unicode.IsSpace(r):
+			i += size
+		case isDigit(r) || r == '.':
+			n := scanNumber(s[i:])
+			v, err := strconv.ParseFloat(s[i:i+n], 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: позиция %d: некорректное число %q", ErrSyntax, i, s[i:i+n])
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: s[i : i+n], num: v, pos: i})
+			i += n
+		case isIdentRune(r):
+			n := strings.IndexFunc(s[i:], func(r rune) bool { return !isIdentRune(r) })
+			if n < 0 {
+				n = len(s) - i
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: s[i : i+n], pos: i})
+			i += n
+		case strings.ContainsRune("+-*/(),", r):
+			tokens = append(tokens, token{kind: tokenOperator, text: s[i : i+size], pos: i})
+			i += size
+		default:
+			return nil, fmt.Errorf("%w: позиция %d: недопустимый символ %q", ErrSyntax, i, r)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(s)}), nil
+}
+
+// scanNumber возвращает длину числа в начале строки: цифры, десятичная точка
+// и показатель степени. Буква e считается показателем степени, только если за
+// ней следуют цифры, поэтому "2e" разбирается как число 2 и обозначение "e".
+func scanNumber(s string) int {
+	i := 0
+	for i < len(s) && (isDigit(rune(s[i])) || s[i] == '.') {
+		i++
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			j++
+		}
+		if j < len(s) && isDigit(rune(s[j])) {
+			for j < len(s) && isDigit(rune(s[j])) {
+				j++
+			}
+			i = j
+		}
+	}
+	return i
+}
+
+// isDigit сообщает, является ли r десятичной цифрой ASCII.
+func isDigit(r rune) bool { return r >= '0' && r <= '9' }
+
+// isIdentRune сообщает, может ли r входить в имя функции или обозначение
+// шкалы. Знак градуса допускается, чтобы "°C" разбиралось как одна лексема.
+func isIdentRune(r rune) bool {
+	return unicode.IsLetter(r) || r == '°' || r == 'º' || r == '_'
+}
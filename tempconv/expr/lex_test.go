@@ -0,0 +1,52 @@
+package expr
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestLex проверяет разбиение выражения на лексемы.
+func TestLex(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"(72F - 20C) in K", []string{"(", "72", "F", "-", "20", "C", ")", "in", "K"}},
+		{"avg(20°C,300 K)", []string{"avg", "(", "20", "°C", ",", "300", "K", ")"}},
+		{"5 ΔF", []string{"5", "ΔF"}},
+		{"1.5e2De", []string{"1.5e2", "De"}},
+		{"2e K", []string{"2", "e", "K"}},
+		{"1e-3*2", []string{"1e-3", "*", "2"}},
+		{"  ", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			tokens, err := lex(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tokens[len(tokens)-1].kind != tokenEOF {
+				t.Fatalf("last token = %v, want EOF", tokens[len(tokens)-1])
+			}
+			tokens = tokens[:len(tokens)-1]
+			if len(tokens) != len(tt.expected) {
+				t.Fatalf("lex(%q) = %v, want %q", tt.input, tokens, tt.expected)
+			}
+			for i, tok := range tokens {
+				if tok.text != tt.expected[i] {
+					t.Errorf("token %d = %q, want %q", i, tok.text, tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+// TestLexErrors проверяет отклонение недопустимых символов и чисел.
+func TestLexErrors(t *testing.T) {
+	for _, input := range []string{"25C; rm -rf /", "1.2.3", "2^3", "\xff"} {
+		if _, err := lex(input); !errors.Is(err, ErrSyntax) {
+			t.Errorf("lex(%q) error = %v, want %v", input, err, ErrSyntax)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// Kind - вид значения выражения.
+type Kind int
+
+// Виды значений
+const (
+	// KindNumber - число без шкалы
+	KindNumber Kind = iota + 1
+	// KindTemperature - абсолютная температура
+	KindTemperature
+	// KindDelta - разность температур
+	KindDelta
+)
+
+// String возвращает название вида значения.
+func (k Kind) String() string {
+	switch k {
+	case KindNumber:
+		return "number"
+	case KindTemperature:
+		return "temperature"
+	case KindDelta:
+		return "delta"
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Value - результат вычисления выражения. Заполнено только поле,
+// соответствующее виду Kind.
+type Value struct {
+	Kind Kind
+	// Number - значение для KindNumber
+	Number float64
+	// Temperature - значение для KindTemperature
+	Temperature tempconv.Temperature
+	// Delta - значение для KindDelta
+	Delta tempconv.Delta
+}
+
+// Number возвращает число без шкалы.
+func Number(v float64) Value { return Value{Kind: KindNumber, Number: v} }
+
+// Temperature возвращает значение с температурой t.
+func Temperature(t tempconv.Temperature) Value { return Value{Kind: KindTemperature, Temperature: t} }
+
+// Delta возвращает значение с разностью температур d.
+func Delta(d tempconv.Delta) Value { return Value{Kind: KindDelta, Delta: d} }
+
+// String возвращает строковое представление значения, например "25.00°C",
+// "Δ2.22K" или "0.5".
+func (v Value) String() string {
+	switch v.Kind {
+	case KindNumber:
+		return strconv.FormatFloat(v.Number, 'g', -1, 64)
+	case KindTemperature:
+		if v.Temperature != nil {
+			return v.Temperature.String()
+		}
+	case KindDelta:
+		return v.Delta.String()
+	}
+	return "<invalid>"
+}
+
+// Scale возвращает шкалу температуры или разности. Для числа возвращается
+// нулевое значение tempconv.Scale.
+func (v Value) Scale() tempconv.Scale {
+	switch v.Kind {
+	case KindTemperature:
+		return tempconv.ScaleOf(v.Temperature)
+	case KindDelta:
+		return v.Delta.Scale
+	}
+	return 0
+}
+
+// Float возвращает числовое значение в собственной шкале значения.
+func (v Value) Float() float64 {
+	switch v.Kind {
+	case KindNumber:
+		return v.Number
+	case KindTemperature:
+		return tempconv.ValueOf(v.Temperature)
+	case KindDelta:
+		return v.Delta.Value
+	}
+	return math.NaN()
+}
+
+// In переводит температуру или разность в шкалу s. Температура
+// преобразуется с учетом положения нуля шкалы, разность - только с учетом
+// цены деления.
+func (v Value) In(s tempconv.Scale) (Value, error) {
+	if !s.Valid() {
+		return Value{}, fmt.Errorf("%w: %v", tempconv.ErrUnknownScale, s)
+	}
+	switch v.Kind {
+	case KindTemperature:
+		return finite(Temperature(s.Convert(v.Temperature)))
+	case KindDelta:
+		return finite(Delta(v.Delta.In(s)))
+	}
+	return Value{}, fmt.Errorf("%w: число без шкалы нельзя перевести в шкалу %v", ErrInvalidOperation, s)
+}
+
+// add возвращает сумму a + b.
+func add(a, b Value) (Value, error) {
+	switch {
+	case a.Kind == KindNumber && b.Kind == KindNumber:
+		return finite(Number(a.Number + b.Number))
+	case a.Kind == KindDelta && b.Kind == KindDelta:
+		return finite(Delta(tempconv.Delta{Value: a.Delta.Value + b.Delta.In(a.Delta.Scale).Value, Scale: a.Delta.Scale}))
+	case a.Kind == KindTemperature && b.Kind == KindDelta:
+		return checked(b.Delta.Add(a.Temperature))
+	case a.Kind == KindDelta && b.Kind == KindTemperature:
+		return checked(a.Delta.Add(b.Temperature))
+	case a.Kind == KindTemperature && b.Kind == KindTemperature:
+		return Value{}, fmt.Errorf("%w: сумма двух температур, используйте разность (например, 5 deltaC)", ErrInvalidOperation)
+	}
+	return Value{}, mismatch("+", a, b)
+}
+
+// sub возвращает разность a - b.
+func sub(a, b Value) (Value, error) {
+	switch {
+	case a.Kind == KindNumber && b.Kind == KindNumber:
+		return finite(Number(a.Number - b.Number))
+	case a.Kind == KindTemperature && b.Kind == KindTemperature:
+		return finite(Delta(tempconv.DeltaBetween(a.Temperature, b.Temperature, tempconv.ScaleOf(a.Temperature))))
+	case a.Kind == KindDelta && b.Kind == KindDelta:
+		return finite(Delta(tempconv.Delta{Value: a.Delta.Value - b.Delta.In(a.Delta.Scale).Value, Scale: a.Delta.Scale}))
+	case a.Kind == KindTemperature && b.Kind == KindDelta:
+		return checked(tempconv.Delta{Value: -b.Delta.Value, Scale: b.Delta.Scale}.Add(a.Temperature))
+	case a.Kind == KindDelta && b.Kind == KindTemperature:
+		return Value{}, fmt.Errorf("%w: вычитание температуры из разности", ErrInvalidOperation)
+	}
+	return Value{}, mismatch("-", a, b)
+}
+
+// mul возвращает произведение a * b.
+func mul(a, b Value) (Value, error) {
+	switch {
+	case a.Kind == KindNumber && b.Kind == KindNumber:
+		return finite(Number(a.Number * b.Number))
+	case a.Kind == KindDelta && b.Kind == KindNumber:
+		return finite(Delta(tempconv.Delta{Value: a.Delta.Value * b.Number, Scale: a.Delta.Scale}))
+	case a.Kind == KindNumber && b.Kind == KindDelta:
+		return finite(Delta(tempconv.Delta{Value: a.Number * b.Delta.Value, Scale: b.Delta.Scale}))
+	}
+	return Value{}, mismatch("*", a, b)
+}
+
+// div возвращает частное a / b.
+func div(a, b Value) (Value, error) {
+	if (b.Kind == KindNumber || b.Kind == KindDelta) && b.Float() == 0 {
+		return Value{}, ErrDivisionByZero
+	}
+	switch {
+	case a.Kind == KindNumber && b.Kind == KindNumber:
+		return finite(Number(a.Number / b.Number))
+	case a.Kind == KindDelta && b.Kind == KindNumber:
+		return finite(Delta(tempconv.Delta{Value: a.Delta.Value / b.Number, Scale: a.Delta.Scale}))
+	case a.Kind == KindDelta && b.Kind == KindDelta:
+		return finite(Number(a.Delta.Kelvins() / b.Delta.Kelvins()))
+	}
+	return Value{}, mismatch("/", a, b)
+}
+
+// neg возвращает -v.
+func neg(v Value) (Value, error) {
+	switch v.Kind {
+	case KindNumber:
+		return finite(Number(-v.Number))
+	case KindDelta:
+		return finite(Delta(tempconv.Delta{Value: -v.Delta.Value, Scale: v.Delta.Scale}))
+	}
+	return Value{}, fmt.Errorf("%w: смена знака температуры, укажите отрицательное значение (например, -40F)", ErrInvalidOperation)
+}
+
+// kelvins возвращает значение, приведенное к кельвинам, для сравнения и
+// усреднения значений одного вида.
+func (v Value) kelvins() float64 {
+	switch v.Kind {
+	case KindTemperature:
+		return float64(v.Temperature.ToKelvin())
+	case KindDelta:
+		return v.Delta.Kelvins()
+	}
+	return v.Number
+}
+
+// checked проверяет, что результат операции конечен и не ниже абсолютного
+// нуля.
+func checked(t tempconv.Temperature) (Value, error) {
+	if _, err := finite(Temperature(t)); err != nil {
+		return Value{}, err
+	}
+	t, err := tempconv.ScaleOf(t).New(tempconv.ValueOf(t))
+	if err != nil {
+		return Value{}, err
+	}
+	return Temperature(t), nil
+}
+
+// finite проверяет, что значение v - конечное число. Переполнение (1e308*10)
+// и неопределенность (Inf-Inf) возвращаются с ошибкой ErrOutOfRange.
+func finite(v Value) (Value, error) {
+	if f := v.Float(); math.IsNaN(f) || math.IsInf(f, 0) {
+		return Value{}, fmt.Errorf("%w: %v", ErrOutOfRange, v)
+	}
+	return v, nil
+}
+
+// mismatch возвращает ошибку операции над значениями несовместимых видов.
+func mismatch(op string, a, b Value) error {
+	if (op == "+" || op == "-") && (a.Kind == KindTemperature || b.Kind == KindTemperature) {
+		return fmt.Errorf("%w: %v %s %v, укажите шкалу разности (например, 5 deltaC)", ErrInvalidOperation, a.Kind, op, b.Kind)
+	}
+	return fmt.Errorf("%w: %v %s %v", ErrInvalidOperation, a.Kind, op, b.Kind)
+}
@@ -0,0 +1,120 @@
+package expr
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// almostEqual проверяет, что два числа почти равны с заданной погрешностью.
+func almostEqual(a, b float64, epsilon float64) bool {
+	return math.Abs(a-b) <= epsilon
+}
+
+// TestValueString проверяет строковое представление значений.
+func TestValueString(t *testing.T) {
+	tests := []struct {
+		value    Value
+		expected string
+	}{
+		{Number(0.5), "0.5"},
+		{Temperature(tempconv.Celsius(25)), "25.00°C"},
+		{Delta(tempconv.Delta{Value: 2.5, Scale: tempconv.ScaleKelvin}), "Δ2.50K"},
+		{Value{}, "<invalid>"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.String(); got != tt.expected {
+			t.Errorf("String() = %q, want %q", got, tt.expected)
+		}
+	}
+}
+
+// TestValueIn проверяет перевод температуры и разности в другую шкалу.
+func TestValueIn(t *testing.T) {
+	v, err := Temperature(tempconv.Celsius(100)).In(tempconv.ScaleFahrenheit)
+	if err != nil || v.Kind != KindTemperature || !almostEqual(v.Float(), 212, 1e-9) {
+		t.Errorf("In(F) = %v, %v, want 212°F", v, err)
+	}
+	v, err = Delta(tempconv.Delta{Value: 100, Scale: tempconv.ScaleCelsius}).In(tempconv.ScaleFahrenheit)
+	if err != nil || v.Kind != KindDelta || !almostEqual(v.Float(), 180, 1e-9) {
+		t.Errorf("In(F) = %v, %v, want Δ180°F", v, err)
+	}
+	if v.Scale() != tempconv.ScaleFahrenheit {
+		t.Errorf("Scale() = %v, want %v", v.Scale(), tempconv.ScaleFahrenheit)
+	}
+	if _, err := Number(1).In(tempconv.ScaleKelvin); !errors.Is(err, ErrInvalidOperation) {
+		t.Errorf("expected error %v, got %v", ErrInvalidOperation, err)
+	}
+	if _, err := Number(1).In(0); !errors.Is(err, tempconv.ErrUnknownScale) {
+		t.Errorf("expected error %v, got %v", tempconv.ErrUnknownScale, err)
+	}
+}
+
+// TestOperations проверяет допустимые сочетания видов значений.
+func TestOperations(t *testing.T) {
+	c := Temperature(tempconv.Celsius(20))
+	f := Temperature(tempconv.Fahrenheit(50))
+	d := Delta(tempconv.Delta{Value: 9, Scale: tempconv.ScaleFahrenheit})
+	n := Number(2)
+
+	tests := []struct {
+		name  string
+		op    func(a, b Value) (Value, error)
+		a, b  Value
+		kind  Kind
+		value float64
+		scale tempconv.Scale
+		err   error
+	}{
+		{"T-T", sub, c, f, KindDelta, 10, tempconv.ScaleCelsius, nil},
+		{"T+D", add, c, d, KindTemperature, 25, tempconv.ScaleCelsius, nil},
+		{"D+T", add, d, c, KindTemperature, 25, tempconv.ScaleCelsius, nil},
+		{"T-D", sub, f, d, KindTemperature, 41, tempconv.ScaleFahrenheit, nil},
+		{"D+D", add, d, Delta(tempconv.Delta{Value: 5, Scale: tempconv.ScaleKelvin}), KindDelta, 18, tempconv.ScaleFahrenheit, nil},
+		{"D*N", mul, d, n, KindDelta, 18, tempconv.ScaleFahrenheit, nil},
+		{"N*D", mul, n, d, KindDelta, 18, tempconv.ScaleFahrenheit, nil},
+		{"D/N", div, d, n, KindDelta, 4.5, tempconv.ScaleFahrenheit, nil},
+		{"D/D", div, d, Delta(tempconv.Delta{Value: 10, Scale: tempconv.ScaleCelsius}), KindNumber, 0.5, 0, nil},
+		{"N/N", div, Number(1), n, KindNumber, 0.5, 0, nil},
+		{"T+T", add, c, f, 0, 0, 0, ErrInvalidOperation},
+		{"T+N", add, c, n, 0, 0, 0, ErrInvalidOperation},
+		{"D-T", sub, d, c, 0, 0, 0, ErrInvalidOperation},
+		{"T*N", mul, c, n, 0, 0, 0, ErrInvalidOperation},
+		{"N/T", div, n, c, 0, 0, 0, ErrInvalidOperation},
+		{"D/0", div, d, Number(0), 0, 0, 0, ErrDivisionByZero},
+		{"Inf-Inf", sub, Number(math.Inf(1)), Number(math.Inf(1)), 0, 0, 0, ErrOutOfRange},
+		{"N*N overflow", mul, Number(1e308), Number(10), 0, 0, 0, ErrOutOfRange},
+		{"T+D overflow", add, Temperature(tempconv.Celsius(1e308)), Delta(tempconv.Delta{Value: 1e308, Scale: tempconv.ScaleCelsius}), 0, 0, 0, ErrOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.op(tt.a, tt.b)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if got.Kind != tt.kind || got.Scale() != tt.scale || !almostEqual(got.Float(), tt.value, 1e-9) {
+				t.Errorf("got %v (%v, %v), want %v %v in %v", got, got.Kind, got.Scale(), tt.kind, tt.value, tt.scale)
+			}
+		})
+	}
+}
+
+// TestAbsoluteZeroCheck проверяет, что результат операции не может оказаться
+// ниже абсолютного нуля.
+func TestAbsoluteZeroCheck(t *testing.T) {
+	k := Temperature(tempconv.Kelvin(1))
+	d := Delta(tempconv.Delta{Value: 2, Scale: tempconv.ScaleCelsius})
+	if _, err := sub(k, d); !errors.Is(err, tempconv.ErrBelowAbsoluteZero) {
+		t.Errorf("expected error %v, got %v", tempconv.ErrBelowAbsoluteZero, err)
+	}
+	if _, err := neg(k); !errors.Is(err, ErrInvalidOperation) {
+		t.Errorf("expected error %v, got %v", ErrInvalidOperation, err)
+	}
+}
@@ -0,0 +1,56 @@
+package tempconv
+
+import "flag"
+
+// Поддержка пакета flag: указатели на все типы температур и на
+// AnyTemperature реализуют flag.Value. Значение флага разбирается так же, как
+// UnmarshalText: число в шкале типа или строка с обозначением шкалы
+// ("-setpoint=72F"), с проверкой абсолютного нуля.
+
+// Set разбирает значение флага и преобразует его в шкалу Цельсия.
+func (c *Celsius) Set(s string) error { return c.UnmarshalText([]byte(s)) }
+
+// Set разбирает значение флага и преобразует его в шкалу Фаренгейта.
+func (f *Fahrenheit) Set(s string) error { return f.UnmarshalText([]byte(s)) }
+
+// Set разбирает значение флага и преобразует его в шкалу Кельвина.
+func (k *Kelvin) Set(s string) error { return k.UnmarshalText([]byte(s)) }
+
+// Set разбирает значение флага и преобразует его в шкалу Ранкина.
+func (r *Rankine) Set(s string) error { return r.UnmarshalText([]byte(s)) }
+
+// Set разбирает значение флага и преобразует его в шкалу Реомюра.
+func (re *Reaumur) Set(s string) error { return re.UnmarshalText([]byte(s)) }
+
+// Set разбирает значение флага и преобразует его в шкалу Делисля.
+func (de *Delisle) Set(s string) error { return de.UnmarshalText([]byte(s)) }
+
+// Set разбирает значение флага и преобразует его в шкалу Ньютона.
+func (n *Newton) Set(s string) error { return n.UnmarshalText([]byte(s)) }
+
+// Set разбирает значение флага со шкалой. Число без обозначения шкалы
+// считается заданным в шкале поля DefaultScale.
+func (a *AnyTemperature) Set(s string) error { return a.UnmarshalText([]byte(s)) }
+
+// flagValue - указатель на тип температуры, реализующий flag.Value.
+type flagValue[T any] interface {
+	*T
+	flag.Value
+}
+
+// FlagVar определяет флаг командной строки с именем name, значением по
+// умолчанию value и описанием usage в наборе flag.CommandLine. Значение флага
+// сохраняется в p:
+//
+//	var setpoint tempconv.Celsius
+//	tempconv.FlagVar(&setpoint, "setpoint", 70, "уставка")
+//	flag.Parse() // -setpoint=160F
+func FlagVar[T any, P flagValue[T]](p *T, name string, value T, usage string) {
+	FlagSetVar[T, P](flag.CommandLine, p, name, value, usage)
+}
+
+// FlagSetVar определяет флаг температуры так же, как FlagVar, в наборе fs.
+func FlagSetVar[T any, P flagValue[T]](fs *flag.FlagSet, p *T, name string, value T, usage string) {
+	*p = value
+	fs.Var(P(p), name, usage)
+}
@@ -0,0 +1,82 @@
+package tempconv
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"testing"
+)
+
+// TestFlagVar проверяет разбор флагов температуры в разных шкалах.
+func TestFlagVar(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	var (
+		setpoint Celsius
+		limit    Kelvin
+		target   AnyTemperature
+	)
+	FlagSetVar(fs, &setpoint, "setpoint", 70, "уставка")
+	FlagSetVar(fs, &limit, "limit", 400, "предел")
+	FlagSetVar(fs, &target, "target", AnyTemperature{Temperature: Celsius(20)}, "цель")
+
+	if setpoint != 70 || limit != 400 {
+		t.Fatalf("defaults = %v, %v, want 70°C, 400K", setpoint, limit)
+	}
+	if err := fs.Parse([]string{"-setpoint=212F", "-target", "72F"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !almostEqual(float64(setpoint), 100, 1e-9) {
+		t.Errorf("setpoint = %v, want 100°C", setpoint)
+	}
+	if limit != 400 {
+		t.Errorf("limit = %v, want default 400K", limit)
+	}
+	if target.Temperature != Fahrenheit(72) {
+		t.Errorf("target = %v, want 72°F", target)
+	}
+	if got := fs.Lookup("setpoint").DefValue; got != Celsius(70).String() {
+		t.Errorf("DefValue = %q, want %q", got, Celsius(70).String())
+	}
+}
+
+// TestFlagSetErrors проверяет, что недопустимые значения флагов отклоняются.
+func TestFlagSetErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		err   error
+	}{
+		{"-300", ErrBelowAbsoluteZero},
+		{"-500F", ErrBelowAbsoluteZero},
+		{"25 X", ErrUnknownScale},
+		{"warm", ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var c Celsius
+			if err := c.Set(tt.input); !errors.Is(err, tt.err) {
+				t.Errorf("Set(%q) error = %v, want %v", tt.input, err, tt.err)
+			}
+
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+			FlagSetVar(fs, &c, "t", 0, "")
+			if err := fs.Parse([]string{"-t=" + tt.input}); err == nil {
+				t.Errorf("Parse(-t=%s) succeeded, want error", tt.input)
+			}
+		})
+	}
+}
+
+// TestFlagValueTypes проверяет, что все типы температур реализуют flag.Value.
+func TestFlagValueTypes(t *testing.T) {
+	values := []flag.Value{
+		new(Celsius), new(Fahrenheit), new(Kelvin), new(Rankine),
+		new(Reaumur), new(Delisle), new(Newton), new(AnyTemperature),
+	}
+	for _, v := range values {
+		if err := v.Set("0 K"); err != nil {
+			t.Errorf("%T.Set(0 K) error = %v", v, err)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+// Пакет humidity содержит психрометрические расчеты влажного воздуха: точку
+// росы, точку инея и относительную влажность.
+//
+// Все функции принимают температуру в любой шкале пакета tempconv и
+// возвращают результат в той же шкале, что и входная температура. Внутри
+// расчеты выполняются в градусах Цельсия.
+//
+// # Формулы:
+//
+// - MagnusTetens — формула Магнуса-Тетенса с коэффициентами Сонтага (1990),
+//
+// - ArdenBuck    — формула Бака (1996), более точная при отрицательных температурах.
+//
+// # Состояние влажного воздуха:
+//
+// Psychrometrics вычисляет полное состояние влажного воздуха (температуры
+// мокрого термометра и точки росы, влагосодержание, парциальное давление пара,
+// энтальпию и удельный объем) по методике ASHRAE Fundamentals. Давление
+// насыщенного пара считается по формулам Хайланда-Векслера. В системе единиц
+// SI температуры возвращаются как tempconv.Celsius, в системе IP - как
+// tempconv.Fahrenheit.
+//
+// # Пример использования:
+//
+//	t := tempconv.Fahrenheit(77)
+//	dew, err := humidity.DewPoint(t, 60, humidity.MagnusTetens)
+//	if err != nil {
+//	    fmt.Println("Ошибка:", err)
+//	    return
+//	}
+//	fmt.Println(dew) // 62.05°F
+package humidity
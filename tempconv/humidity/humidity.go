@@ -0,0 +1,183 @@
+package humidity
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// Ошибки психрометрических расчетов
+var (
+	ErrInvalidHumidity = errors.New("относительная влажность вне диапазона (0, 100]%")
+	ErrInvalidDewPoint = errors.New("точка росы выше температуры воздуха")
+	ErrUnknownFormula  = errors.New("неизвестная формула давления насыщенного пара")
+)
+
+// Formula - формула давления насыщенного водяного пара.
+type Formula int
+
+// Поддерживаемые формулы
+const (
+	// MagnusTetens - формула Магнуса-Тетенса
+	MagnusTetens Formula = iota
+	// ArdenBuck - формула Бака
+	ArdenBuck
+)
+
+// Коэффициенты формул давления насыщенного пара (давление в гПа, температура в °C)
+const (
+	// magnusA - давление насыщенного пара при 0°C
+	magnusA = 6.112
+	// magnusWaterB, magnusWaterC - коэффициенты над водой
+	magnusWaterB = 17.62
+	magnusWaterC = 243.12
+	// magnusIceB, magnusIceC - коэффициенты надо льдом
+	magnusIceB = 22.46
+	magnusIceC = 272.62
+
+	// buckWaterA, buckWaterB, buckWaterC, buckWaterD - коэффициенты Бака над водой
+	buckWaterA = 6.1121
+	buckWaterB = 18.678
+	buckWaterC = 257.14
+	buckWaterD = 234.5
+	// buckIceA, buckIceB, buckIceC, buckIceD - коэффициенты Бака надо льдом
+	buckIceA = 6.1115
+	buckIceB = 23.036
+	buckIceC = 279.82
+	buckIceD = 333.7
+)
+
+// String возвращает название формулы.
+func (f Formula) String() string {
+	switch f {
+	case MagnusTetens:
+		return "Magnus-Tetens"
+	case ArdenBuck:
+		return "Arden Buck"
+	}
+	return fmt.Sprintf("Formula(%d)", int(f))
+}
+
+// SaturationVaporPressure возвращает давление насыщенного пара над водой в гПа
+// при температуре t.
+func SaturationVaporPressure(t tempconv.Temperature, f Formula) (float64, error) {
+	if err := f.validate(); err != nil {
+		return 0, err
+	}
+	return f.saturation(float64(t.ToCelsius()), false), nil
+}
+
+// VaporPressure возвращает парциальное давление водяного пара в гПа при
+// температуре t и относительной влажности rh (в процентах).
+func VaporPressure(t tempconv.Temperature, rh float64, f Formula) (float64, error) {
+	if err := f.validate(); err != nil {
+		return 0, err
+	}
+	if err := validateHumidity(rh); err != nil {
+		return 0, err
+	}
+	return rh / 100 * f.saturation(float64(t.ToCelsius()), false), nil
+}
+
+// DewPoint вычисляет точку росы по температуре воздуха t и относительной
+// влажности rh (в процентах). Результат возвращается в шкале t.
+func DewPoint(t tempconv.Temperature, rh float64, f Formula) (tempconv.Temperature, error) {
+	e, err := VaporPressure(t, rh, f)
+	if err != nil {
+		return nil, err
+	}
+	return inScaleOf(t, f.inverse(e, false)), nil
+}
+
+// FrostPoint вычисляет точку инея - температуру, при которой пар с тем же
+// парциальным давлением насыщает воздух надо льдом. Результат возвращается в
+// шкале t.
+func FrostPoint(t tempconv.Temperature, rh float64, f Formula) (tempconv.Temperature, error) {
+	e, err := VaporPressure(t, rh, f)
+	if err != nil {
+		return nil, err
+	}
+	return inScaleOf(t, f.inverse(e, true)), nil
+}
+
+// RelativeHumidity вычисляет относительную влажность (в процентах) по
+// температуре воздуха t и точке росы dew. Шкалы t и dew могут различаться.
+func RelativeHumidity(t, dew tempconv.Temperature, f Formula) (float64, error) {
+	if err := f.validate(); err != nil {
+		return 0, err
+	}
+	tc, dc := float64(t.ToCelsius()), float64(dew.ToCelsius())
+	if dc > tc {
+		return 0, fmt.Errorf("%w: %v > %v", ErrInvalidDewPoint, dew, t)
+	}
+	return 100 * f.saturation(dc, false) / f.saturation(tc, false), nil
+}
+
+// validate проверяет, что формула поддерживается.
+func (f Formula) validate() error {
+	if f != MagnusTetens && f != ArdenBuck {
+		return fmt.Errorf("%w: %v", ErrUnknownFormula, f)
+	}
+	return nil
+}
+
+// saturation возвращает давление насыщенного пара в гПа при температуре tc (°C)
+// над водой или надо льдом.
+func (f Formula) saturation(tc float64, ice bool) float64 {
+	if f == ArdenBuck {
+		a, b, c, d := buckCoefficients(ice)
+		return a * math.Exp((b-tc/d)*(tc/(c+tc)))
+	}
+	b, c := magnusCoefficients(ice)
+	return magnusA * math.Exp(b*tc/(c+tc))
+}
+
+// inverse возвращает температуру (°C), при которой давление насыщенного пара
+// равно e гПа.
+func (f Formula) inverse(e float64, ice bool) float64 {
+	if f == ArdenBuck {
+		// (b - T/d)·T/(c+T) = γ сводится к T² - d(b-γ)T + dcγ = 0;
+		// физический смысл имеет меньший корень.
+		a, b, c, d := buckCoefficients(ice)
+		g := math.Log(e / a)
+		p := d * (b - g)
+		return (p - math.Sqrt(p*p-4*d*c*g)) / 2
+	}
+	b, c := magnusCoefficients(ice)
+	g := math.Log(e / magnusA)
+	return c * g / (b - g)
+}
+
+// magnusCoefficients возвращает коэффициенты формулы Магнуса.
+func magnusCoefficients(ice bool) (b, c float64) {
+	if ice {
+		return magnusIceB, magnusIceC
+	}
+	return magnusWaterB, magnusWaterC
+}
+
+// buckCoefficients возвращает коэффициенты формулы Бака.
+func buckCoefficients(ice bool) (a, b, c, d float64) {
+	if ice {
+		return buckIceA, buckIceB, buckIceC, buckIceD
+	}
+	return buckWaterA, buckWaterB, buckWaterC, buckWaterD
+}
+
+// validateHumidity проверяет, что относительная влажность лежит в (0, 100].
+func validateHumidity(rh float64) error {
+	if !(rh > 0 && rh <= 100) {
+		return fmt.Errorf("%w: %.2f%%", ErrInvalidHumidity, rh)
+	}
+	return nil
+}
+
+// inScaleOf возвращает температуру tc (°C) в шкале температуры t.
+func inScaleOf(t tempconv.Temperature, tc float64) tempconv.Temperature {
+	if s := tempconv.ScaleOf(t); s.Valid() {
+		return s.Convert(tempconv.Celsius(tc))
+	}
+	return tempconv.Celsius(tc)
+}
@@ -0,0 +1,114 @@
+package humidity
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// almostEqual проверяет, что два числа почти равны с заданной погрешностью.
+func almostEqual(a, b float64, epsilon float64) bool {
+	return math.Abs(a-b) <= epsilon
+}
+
+// TestDewPoint проверяет расчет точки росы по опорным значениям.
+func TestDewPoint(t *testing.T) {
+	tests := []struct {
+		temp     tempconv.Temperature
+		rh       float64
+		formula  Formula
+		expected float64
+	}{
+		{tempconv.Celsius(25), 60, MagnusTetens, 16.69},
+		{tempconv.Celsius(25), 60, ArdenBuck, 16.70},
+		{tempconv.Celsius(20), 100, MagnusTetens, 20},
+		{tempconv.Celsius(0), 50, ArdenBuck, -9.18},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("DewPoint %v %v%% %v", tt.temp, tt.rh, tt.formula), func(t *testing.T) {
+			dew, err := DewPoint(tt.temp, tt.rh, tt.formula)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := float64(dew.ToCelsius()); !almostEqual(got, tt.expected, 0.01) {
+				t.Errorf("DewPoint() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestDewPointKeepsScale проверяет, что результат возвращается в шкале входной
+// температуры.
+func TestDewPointKeepsScale(t *testing.T) {
+	for _, s := range tempconv.Scales() {
+		t.Run(s.String(), func(t *testing.T) {
+			dew, err := DewPoint(s.Convert(tempconv.Celsius(25)), 60, MagnusTetens)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if dew.ScaleName() != s.String() {
+				t.Errorf("ScaleName() = %v, want %v", dew.ScaleName(), s)
+			}
+			if got := float64(dew.ToCelsius()); !almostEqual(got, 16.69, 0.01) {
+				t.Errorf("DewPoint() = %v, want 16.69°C", got)
+			}
+		})
+	}
+}
+
+// TestRelativeHumidityRoundTrip проверяет, что RelativeHumidity обращает DewPoint.
+func TestRelativeHumidityRoundTrip(t *testing.T) {
+	for _, f := range []Formula{MagnusTetens, ArdenBuck} {
+		for _, rh := range []float64{5, 35, 80, 100} {
+			temp := tempconv.Fahrenheit(41)
+			dew, err := DewPoint(temp, rh, f)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got, err := RelativeHumidity(temp, dew, f)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !almostEqual(got, rh, 1e-6) {
+				t.Errorf("%v: RelativeHumidity() = %v, want %v", f, got, rh)
+			}
+		}
+	}
+}
+
+// TestFrostPoint проверяет, что точка инея выше точки росы при отрицательных
+// температурах и совпадает с ней при 0°C и насыщении.
+func TestFrostPoint(t *testing.T) {
+	temp := tempconv.Celsius(-5)
+	dew, _ := DewPoint(temp, 80, ArdenBuck)
+	frost, err := FrostPoint(temp, 80, ArdenBuck)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if frost.ToCelsius() <= dew.ToCelsius() {
+		t.Errorf("FrostPoint() = %v, must be above DewPoint() = %v", frost, dew)
+	}
+	if got := float64(frost.ToCelsius()); !almostEqual(got, -7.03, 0.01) {
+		t.Errorf("FrostPoint() = %v, want -7.03", got)
+	}
+}
+
+// TestErrors проверяет обработку некорректных входных данных.
+func TestErrors(t *testing.T) {
+	if _, err := DewPoint(tempconv.Celsius(20), 0, MagnusTetens); !errors.Is(err, ErrInvalidHumidity) {
+		t.Errorf("expected error %v, got %v", ErrInvalidHumidity, err)
+	}
+	if _, err := DewPoint(tempconv.Celsius(20), 101, MagnusTetens); !errors.Is(err, ErrInvalidHumidity) {
+		t.Errorf("expected error %v, got %v", ErrInvalidHumidity, err)
+	}
+	if _, err := DewPoint(tempconv.Celsius(20), 50, Formula(7)); !errors.Is(err, ErrUnknownFormula) {
+		t.Errorf("expected error %v, got %v", ErrUnknownFormula, err)
+	}
+	if _, err := RelativeHumidity(tempconv.Celsius(20), tempconv.Celsius(21), ArdenBuck); !errors.Is(err, ErrInvalidDewPoint) {
+		t.Errorf("expected error %v, got %v", ErrInvalidDewPoint, err)
+	}
+}
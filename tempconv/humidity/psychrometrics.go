@@ -0,0 +1,302 @@
+package humidity
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// Ошибки расчета состояния влажного воздуха
+var (
+	ErrInvalidPressure      = errors.New("недопустимое атмосферное давление")
+	ErrInvalidHumidityRatio = errors.New("недопустимое влагосодержание")
+	ErrInvalidWetBulb       = errors.New("температура мокрого термометра выше температуры воздуха")
+	ErrUnknownUnitSystem    = errors.New("неизвестная система единиц")
+	ErrTemperatureRange     = errors.New("температура вне области применимости психрометрических формул")
+)
+
+// UnitSystem - система единиц психрометрических расчетов.
+type UnitSystem int
+
+// Поддерживаемые системы единиц
+const (
+	// SI - температуры в °C, давление в Па, энтальпия в кДж/кг,
+	// удельный объем в м³/кг сухого воздуха
+	SI UnitSystem = iota
+	// IP - температуры в °F, давление в psi, энтальпия в Btu/lb,
+	// удельный объем в ft³/lb сухого воздуха
+	IP
+)
+
+// Константы ASHRAE Handbook Fundamentals (2017), глава 1
+const (
+	// molarMassRatio - отношение молярных масс водяного пара и сухого воздуха
+	molarMassRatio = 0.621945
+	// StandardPressurePa - стандартное атмосферное давление на уровне моря, Па
+	StandardPressurePa = 101325.0
+	// StandardPressurePsi - стандартное атмосферное давление на уровне моря, psi
+	StandardPressurePsi = 14.696
+	// paPerPsi - количество паскалей в одном psi
+	paPerPsi = 6894.757
+	// cubicFeetPerPoundPerCubicMeterPerKg - пересчет м³/кг в ft³/lb
+	cubicFeetPerPoundPerCubicMeterPerKg = 16.018463
+	// airGasConstant - удельная газовая постоянная сухого воздуха, кДж/(кг·К)
+	airGasConstant = 0.287042
+	// vaporVolumeFactor - поправка на объем водяного пара в формуле удельного объема
+	vaporVolumeFactor = 1.607858
+
+	// minPsychrometricC, maxPsychrometricC - границы применимости формул Хайланда-Векслера, °C
+	minPsychrometricC = -100.0
+	maxPsychrometricC = 200.0
+	// wetBulbTolerance - точность итерационного поиска температур, °C
+	wetBulbTolerance = 1e-9
+)
+
+// Коэффициенты Хайланда-Векслера для давления насыщенного пара надо льдом
+const (
+	hwIce1 = -5.6745359e3
+	hwIce2 = 6.3925247
+	hwIce3 = -9.677843e-3
+	hwIce4 = 6.2215701e-7
+	hwIce5 = 2.0747825e-9
+	hwIce6 = -9.484024e-13
+	hwIce7 = 4.1635019
+)
+
+// Коэффициенты Хайланда-Векслера для давления насыщенного пара над водой
+const (
+	hwWater8  = -5.8002206e3
+	hwWater9  = 1.3914993
+	hwWater10 = -4.8640239e-2
+	hwWater11 = 4.1764768e-5
+	hwWater12 = -1.4452093e-8
+	hwWater13 = 6.5459673
+)
+
+// State - полное состояние влажного воздуха. Температуры задаются типом
+// tempconv.Celsius для системы SI и tempconv.Fahrenheit для системы IP,
+// остальные величины - в единицах выбранной системы.
+type State struct {
+	// Units - система единиц состояния
+	Units UnitSystem
+	// Pressure - атмосферное давление (Па или psi)
+	Pressure float64
+	// DryBulb - температура сухого термометра
+	DryBulb tempconv.Temperature
+	// WetBulb - температура мокрого термометра (термодинамическая)
+	WetBulb tempconv.Temperature
+	// DewPoint - точка росы
+	DewPoint tempconv.Temperature
+	// RelativeHumidity - относительная влажность в процентах
+	RelativeHumidity float64
+	// HumidityRatio - влагосодержание, кг/кг (lb/lb) сухого воздуха
+	HumidityRatio float64
+	// VaporPressure - парциальное давление водяного пара (Па или psi)
+	VaporPressure float64
+	// Enthalpy - удельная энтальпия (кДж/кг или Btu/lb сухого воздуха)
+	Enthalpy float64
+	// SpecificVolume - удельный объем (м³/кг или ft³/lb сухого воздуха)
+	SpecificVolume float64
+}
+
+// Psychrometrics - калькулятор состояния влажного воздуха по методике ASHRAE
+// Fundamentals при заданном атмосферном давлении.
+type Psychrometrics struct {
+	units    UnitSystem
+	pressure float64 // давление в Па
+}
+
+// NewPsychrometrics создает калькулятор для системы единиц units и
+// атмосферного давления pressure, заданного в Па (SI) или psi (IP).
+func NewPsychrometrics(units UnitSystem, pressure float64) (*Psychrometrics, error) {
+	if units != SI && units != IP {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownUnitSystem, int(units))
+	}
+	if !(pressure > 0) || math.IsInf(pressure, 1) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPressure, pressure)
+	}
+	if units == IP {
+		pressure *= paPerPsi
+	}
+	return &Psychrometrics{units: units, pressure: pressure}, nil
+}
+
+// FromRelativeHumidity вычисляет состояние по температуре сухого термометра и
+// относительной влажности rh в процентах.
+func (p *Psychrometrics) FromRelativeHumidity(dryBulb tempconv.Temperature, rh float64) (State, error) {
+	tc, err := psychrometricTemperature(dryBulb)
+	if err != nil {
+		return State{}, err
+	}
+	if err := validateHumidity(rh); err != nil {
+		return State{}, err
+	}
+	return p.state(tc, rh/100*saturationPressure(tc))
+}
+
+// FromWetBulb вычисляет состояние по температурам сухого и мокрого
+// термометров.
+func (p *Psychrometrics) FromWetBulb(dryBulb, wetBulb tempconv.Temperature) (State, error) {
+	tc, err := psychrometricTemperature(dryBulb)
+	if err != nil {
+		return State{}, err
+	}
+	wc, err := psychrometricTemperature(wetBulb)
+	if err != nil {
+		return State{}, err
+	}
+	if wc > tc {
+		return State{}, fmt.Errorf("%w: %v > %v", ErrInvalidWetBulb, wetBulb, dryBulb)
+	}
+	w := humidityRatioFromWetBulb(tc, wc, p.pressure)
+	if w <= 0 {
+		return State{}, fmt.Errorf("%w: мокрый термометр %v дает %v", ErrInvalidHumidityRatio, wetBulb, w)
+	}
+	return p.state(tc, vaporPressureFromRatio(w, p.pressure))
+}
+
+// FromDewPoint вычисляет состояние по температуре сухого термометра и точке
+// росы.
+func (p *Psychrometrics) FromDewPoint(dryBulb, dewPoint tempconv.Temperature) (State, error) {
+	tc, err := psychrometricTemperature(dryBulb)
+	if err != nil {
+		return State{}, err
+	}
+	dc, err := psychrometricTemperature(dewPoint)
+	if err != nil {
+		return State{}, err
+	}
+	if dc > tc {
+		return State{}, fmt.Errorf("%w: %v > %v", ErrInvalidDewPoint, dewPoint, dryBulb)
+	}
+	return p.state(tc, saturationPressure(dc))
+}
+
+// FromHumidityRatio вычисляет состояние по температуре сухого термометра и
+// влагосодержанию w (кг/кг или lb/lb сухого воздуха).
+func (p *Psychrometrics) FromHumidityRatio(dryBulb tempconv.Temperature, w float64) (State, error) {
+	tc, err := psychrometricTemperature(dryBulb)
+	if err != nil {
+		return State{}, err
+	}
+	if !(w > 0) {
+		return State{}, fmt.Errorf("%w: %v", ErrInvalidHumidityRatio, w)
+	}
+	return p.state(tc, vaporPressureFromRatio(w, p.pressure))
+}
+
+// state собирает полное состояние по температуре tc (°C) и парциальному
+// давлению пара pw (Па).
+func (p *Psychrometrics) state(tc, pw float64) (State, error) {
+	pws := saturationPressure(tc)
+	if pw > pws*(1+1e-12) || pw >= p.pressure {
+		return State{}, fmt.Errorf("%w: пар %.2f Па пересыщает воздух при %.2f°C", ErrInvalidHumidityRatio, pw, tc)
+	}
+	pw = math.Min(pw, pws)
+	w := molarMassRatio * pw / (p.pressure - pw)
+	dc := dewPointFromPressure(pw)
+	wc := wetBulbFromRatio(tc, w, dc, p.pressure)
+
+	s := State{
+		Units:            p.units,
+		RelativeHumidity: 100 * pw / pws,
+		HumidityRatio:    w,
+	}
+	if p.units == IP {
+		tf := float64(tempconv.Celsius(tc).ToFahrenheit())
+		s.Pressure = p.pressure / paPerPsi
+		s.DryBulb = tempconv.Fahrenheit(tf)
+		s.WetBulb = tempconv.Celsius(wc).ToFahrenheit()
+		s.DewPoint = tempconv.Celsius(dc).ToFahrenheit()
+		s.VaporPressure = pw / paPerPsi
+		s.Enthalpy = 0.240*tf + w*(1061+0.444*tf)
+		s.SpecificVolume = specificVolume(tc, w, p.pressure) * cubicFeetPerPoundPerCubicMeterPerKg
+		return s, nil
+	}
+	s.Pressure = p.pressure
+	s.DryBulb = tempconv.Celsius(tc)
+	s.WetBulb = tempconv.Celsius(wc)
+	s.DewPoint = tempconv.Celsius(dc)
+	s.VaporPressure = pw
+	s.Enthalpy = 1.006*tc + w*(2501+1.86*tc)
+	s.SpecificVolume = specificVolume(tc, w, p.pressure)
+	return s, nil
+}
+
+// psychrometricTemperature переводит температуру в °C и проверяет, что она
+// лежит в области применимости формул.
+func psychrometricTemperature(t tempconv.Temperature) (float64, error) {
+	tc := float64(t.ToCelsius())
+	if !(tc >= minPsychrometricC && tc <= maxPsychrometricC) {
+		return 0, fmt.Errorf("%w: %v вне диапазона [%.0f, %.0f]°C",
+			ErrTemperatureRange, t, minPsychrometricC, maxPsychrometricC)
+	}
+	return tc, nil
+}
+
+// saturationPressure возвращает давление насыщенного пара в Па по формулам
+// Хайланда-Векслера: надо льдом ниже 0°C и над водой при 0°C и выше.
+func saturationPressure(tc float64) float64 {
+	tk := tc + 273.15
+	if tc < 0 {
+		return math.Exp(hwIce1/tk + hwIce2 + hwIce3*tk + hwIce4*tk*tk +
+			hwIce5*tk*tk*tk + hwIce6*tk*tk*tk*tk + hwIce7*math.Log(tk))
+	}
+	return math.Exp(hwWater8/tk + hwWater9 + hwWater10*tk + hwWater11*tk*tk +
+		hwWater12*tk*tk*tk + hwWater13*math.Log(tk))
+}
+
+// dewPointFromPressure возвращает точку росы (°C), при которой давление
+// насыщенного пара равно pw. Поиск выполняется бисекцией.
+func dewPointFromPressure(pw float64) float64 {
+	return bisect(minPsychrometricC, maxPsychrometricC, func(tc float64) bool {
+		return saturationPressure(tc) < pw
+	})
+}
+
+// humidityRatioFromWetBulb возвращает влагосодержание по температурам сухого
+// tc и мокрого wc термометров (°C) при давлении pressure (Па).
+func humidityRatioFromWetBulb(tc, wc, pressure float64) float64 {
+	pws := saturationPressure(wc)
+	ws := molarMassRatio * pws / (pressure - pws)
+	if wc >= 0 {
+		return ((2501-2.326*wc)*ws - 1.006*(tc-wc)) / (2501 + 1.86*tc - 4.186*wc)
+	}
+	return ((2830-0.24*wc)*ws - 1.006*(tc-wc)) / (2830 + 1.86*tc - 2.1*wc)
+}
+
+// wetBulbFromRatio находит температуру мокрого термометра (°C) между точкой
+// росы dc и температурой воздуха tc, при которой влагосодержание равно w.
+func wetBulbFromRatio(tc, w, dc, pressure float64) float64 {
+	return bisect(dc, tc, func(wc float64) bool {
+		return humidityRatioFromWetBulb(tc, wc, pressure) < w
+	})
+}
+
+// vaporPressureFromRatio возвращает парциальное давление пара (Па) по
+// влагосодержанию w при давлении pressure (Па).
+func vaporPressureFromRatio(w, pressure float64) float64 {
+	return pressure * w / (molarMassRatio + w)
+}
+
+// specificVolume возвращает удельный объем влажного воздуха в м³/кг сухого
+// воздуха.
+func specificVolume(tc, w, pressure float64) float64 {
+	return airGasConstant * (tc + 273.15) * (1 + vaporVolumeFactor*w) / (pressure / 1000)
+}
+
+// bisect находит границу на отрезке [lo, hi], где below меняет значение с true
+// на false.
+func bisect(lo, hi float64, below func(float64) bool) float64 {
+	for hi-lo > wetBulbTolerance {
+		mid := (lo + hi) / 2
+		if below(mid) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
@@ -0,0 +1,139 @@
+package humidity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// TestPsychrometricsSI проверяет состояние влажного воздуха в единицах SI по
+// опорным значениям ASHRAE.
+func TestPsychrometricsSI(t *testing.T) {
+	p, err := NewPsychrometrics(SI, StandardPressurePa)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s, err := p.FromRelativeHumidity(tempconv.Celsius(25), 50)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	checks := []struct {
+		name     string
+		got      float64
+		expected float64
+		epsilon  float64
+	}{
+		{"HumidityRatio", s.HumidityRatio, 0.009881, 1e-6},
+		{"WetBulb", float64(s.WetBulb.ToCelsius()), 17.89, 0.01},
+		{"DewPoint", float64(s.DewPoint.ToCelsius()), 13.86, 0.01},
+		{"VaporPressure", s.VaporPressure, 1584.6, 0.1},
+		{"Enthalpy", s.Enthalpy, 50.32, 0.01},
+		{"SpecificVolume", s.SpecificVolume, 0.8580, 1e-4},
+	}
+	for _, c := range checks {
+		if !almostEqual(c.got, c.expected, c.epsilon) {
+			t.Errorf("%s = %v, want %v", c.name, c.got, c.expected)
+		}
+	}
+	if _, ok := s.DryBulb.(tempconv.Celsius); !ok {
+		t.Errorf("DryBulb has type %T, want tempconv.Celsius", s.DryBulb)
+	}
+}
+
+// TestPsychrometricsIP проверяет, что система IP возвращает температуры в
+// Фаренгейтах и согласуется с расчетом в SI.
+func TestPsychrometricsIP(t *testing.T) {
+	p, err := NewPsychrometrics(IP, StandardPressurePsi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s, err := p.FromRelativeHumidity(tempconv.Celsius(25), 50)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := s.WetBulb.(tempconv.Fahrenheit); !ok {
+		t.Fatalf("WetBulb has type %T, want tempconv.Fahrenheit", s.WetBulb)
+	}
+	if got := float64(s.WetBulb.ToFahrenheit()); !almostEqual(got, 64.20, 0.01) {
+		t.Errorf("WetBulb = %v, want 64.20°F", got)
+	}
+	if !almostEqual(s.Enthalpy, 29.30, 0.01) {
+		t.Errorf("Enthalpy = %v, want 29.30 Btu/lb", s.Enthalpy)
+	}
+	if !almostEqual(s.SpecificVolume, 13.74, 0.01) {
+		t.Errorf("SpecificVolume = %v, want 13.74 ft³/lb", s.SpecificVolume)
+	}
+}
+
+// TestPsychrometricsInputs проверяет, что все способы задания влажности
+// приводят к одному и тому же состоянию.
+func TestPsychrometricsInputs(t *testing.T) {
+	p, _ := NewPsychrometrics(SI, StandardPressurePa)
+	base, err := p.FromWetBulb(tempconv.Celsius(30), tempconv.Celsius(25))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !almostEqual(base.HumidityRatio, 0.01795, 1e-5) {
+		t.Errorf("HumidityRatio = %v, want 0.01795", base.HumidityRatio)
+	}
+
+	fromDew, err := p.FromDewPoint(tempconv.Celsius(30), base.DewPoint)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fromRatio, err := p.FromHumidityRatio(tempconv.Fahrenheit(86), base.HumidityRatio)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fromRH, err := p.FromRelativeHumidity(tempconv.Kelvin(303.15), base.RelativeHumidity)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, s := range []State{fromDew, fromRatio, fromRH} {
+		if !almostEqual(float64(s.WetBulb.ToCelsius()), 25, 1e-6) {
+			t.Errorf("WetBulb = %v, want 25°C", s.WetBulb)
+		}
+		if !almostEqual(s.HumidityRatio, base.HumidityRatio, 1e-9) {
+			t.Errorf("HumidityRatio = %v, want %v", s.HumidityRatio, base.HumidityRatio)
+		}
+	}
+}
+
+// TestPsychrometricsBelowFreezing проверяет расчет давления насыщенного пара
+// надо льдом.
+func TestPsychrometricsBelowFreezing(t *testing.T) {
+	p, _ := NewPsychrometrics(SI, StandardPressurePa)
+	s, err := p.FromDewPoint(tempconv.Celsius(-5), tempconv.Celsius(-10))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !almostEqual(s.VaporPressure, 259.90, 0.01) {
+		t.Errorf("VaporPressure = %v, want 259.90 Па", s.VaporPressure)
+	}
+}
+
+// TestPsychrometricsErrors проверяет обработку некорректных входных данных.
+func TestPsychrometricsErrors(t *testing.T) {
+	if _, err := NewPsychrometrics(SI, 0); !errors.Is(err, ErrInvalidPressure) {
+		t.Errorf("expected error %v, got %v", ErrInvalidPressure, err)
+	}
+	if _, err := NewPsychrometrics(UnitSystem(5), StandardPressurePa); !errors.Is(err, ErrUnknownUnitSystem) {
+		t.Errorf("expected error %v, got %v", ErrUnknownUnitSystem, err)
+	}
+
+	p, _ := NewPsychrometrics(SI, StandardPressurePa)
+	if _, err := p.FromWetBulb(tempconv.Celsius(20), tempconv.Celsius(21)); !errors.Is(err, ErrInvalidWetBulb) {
+		t.Errorf("expected error %v, got %v", ErrInvalidWetBulb, err)
+	}
+	if _, err := p.FromDewPoint(tempconv.Celsius(20), tempconv.Celsius(21)); !errors.Is(err, ErrInvalidDewPoint) {
+		t.Errorf("expected error %v, got %v", ErrInvalidDewPoint, err)
+	}
+	if _, err := p.FromHumidityRatio(tempconv.Celsius(20), 0.5); !errors.Is(err, ErrInvalidHumidityRatio) {
+		t.Errorf("expected error %v, got %v", ErrInvalidHumidityRatio, err)
+	}
+	if _, err := p.FromRelativeHumidity(tempconv.Celsius(300), 50); !errors.Is(err, ErrTemperatureRange) {
+		t.Errorf("expected error %v, got %v", ErrTemperatureRange, err)
+	}
+}
@@ -0,0 +1,181 @@
+package influx
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxLineSize - максимальная длина строки протокола
+const maxLineSize = 1 << 20
+
+// Decoder читает точки из текстового протокола InfluxDB. Пустые строки и
+// комментарии (#) пропускаются; поля, отличные от поля температуры,
+// игнорируются.
+type Decoder struct {
+	s         *bufio.Scanner
+	line      int
+	precision time.Duration
+}
+
+// NewDecoder создает декодер, читающий из r. По умолчанию метки времени
+// считаются заданными в наносекундах.
+func NewDecoder(r io.Reader) *Decoder {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	return &Decoder{s: s, precision: time.Nanosecond}
+}
+
+// SetPrecision задает точность меток времени: time.Nanosecond,
+// time.Microsecond, time.Millisecond или time.Second.
+func (d *Decoder) SetPrecision(p time.Duration) error {
+	if err := checkPrecision(p); err != nil {
+		return err
+	}
+	d.precision = p
+	return nil
+}
+
+// Decode читает следующую точку. В конце входных данных возвращается io.EOF.
+func (d *Decoder) Decode() (Point, error) {
+	for d.s.Scan() {
+		d.line++
+		line := strings.TrimSpace(d.s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		p, err := d.parse(line)
+		if err != nil {
+			return Point{}, fmt.Errorf("строка %d: %w", d.line, err)
+		}
+		return p, nil
+	}
+	if err := d.s.Err(); err != nil {
+		return Point{}, err
+	}
+	return Point{}, io.EOF
+}
+
+// parse разбирает одну строку протокола.
+func (d *Decoder) parse(line string) (Point, error) {
+	sections := split(line, ' ')
+	if len(sections) < 2 || len(sections) > 3 {
+		return Point{}, fmt.Errorf("%w: ожидается 2 или 3 раздела, получено %d", ErrSyntax, len(sections))
+	}
+
+	var p Point
+	key := split(sections[0], ',')
+	p.Measurement = unescape(key[0])
+	if p.Measurement == "" {
+		return Point{}, fmt.Errorf("%w: пустое имя измерения", ErrSyntax)
+	}
+	for _, tag := range key[1:] {
+		k, v, ok := cut(tag)
+		if !ok || k == "" || v == "" {
+			return Point{}, fmt.Errorf("%w: метка %q", ErrSyntax, tag)
+		}
+		if p.Tags == nil {
+			p.Tags = make(map[string]string)
+		}
+		p.Tags[unescape(k)] = unescape(v)
+	}
+
+	for _, field := range split(sections[1], ',') {
+		k, v, ok := cut(field)
+		if !ok || k == "" || v == "" {
+			return Point{}, fmt.Errorf("%w: поле %q", ErrSyntax, field)
+		}
+		scale, ok := ScaleOfField(unescape(k))
+		if !ok || p.Temperature != nil {
+			continue
+		}
+		num, err := parseNumber(v)
+		if err != nil {
+			return Point{}, fmt.Errorf("%w: значение поля %q", ErrSyntax, field)
+		}
+		if p.Temperature, err = scale.New(num); err != nil {
+			return Point{}, err
+		}
+	}
+	if p.Temperature == nil {
+		return Point{}, ErrNoTemperature
+	}
+
+	if len(sections) == 3 {
+		ts, err := strconv.ParseInt(sections[2], 10, 64)
+		if err != nil {
+			return Point{}, fmt.Errorf("%w: метка времени %q", ErrSyntax, sections[2])
+		}
+		// Метка времени должна помещаться в int64 наносекунд
+		if n := int64(d.precision); ts > math.MaxInt64/n || ts < math.MinInt64/n {
+			return Point{}, fmt.Errorf("%w: метка времени %q вне диапазона", ErrSyntax, sections[2])
+		}
+		p.Time = time.Unix(0, ts*int64(d.precision)).UTC()
+	}
+	return p, nil
+}
+
+// parseNumber разбирает числовое значение поля: целое с суффиксом "i",
+// беззнаковое с суффиксом "u" или конечное число с плавающей точкой.
+func parseNumber(v string) (float64, error) {
+	switch {
+	case strings.HasSuffix(v, "i"):
+		n, err := strconv.ParseInt(v[:len(v)-1], 10, 64)
+		return float64(n), err
+	case strings.HasSuffix(v, "u"):
+		n, err := strconv.ParseUint(v[:len(v)-1], 10, 64)
+		return float64(n), err
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err == nil && (math.IsNaN(f) || math.IsInf(f, 0)) {
+		return 0, strconv.ErrSyntax
+	}
+	return f, err
+}
+
+// split разделяет s по неэкранированным разделителям sep вне строк в
+// кавычках.
+func split(s string, sep byte) []string {
+	var parts []string
+	start, quoted := 0, false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\':
+			i++
+		case c == '"':
+			quoted = !quoted
+		case c == sep && !quoted:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// cut разделяет пару ключ=значение по первому неэкранированному знаку '='.
+func cut(s string) (key, value string, ok bool) {
+	parts := split(s, '=')
+	if len(parts) < 2 {
+		return "", "", false
+	}
+	return parts[0], s[len(parts[0])+1:], true
+}
+
+// unescape удаляет экранирование специальных символов.
+func unescape(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && strings.IndexByte(`, ="\`, s[i+1]) >= 0 {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,106 @@
+package influx
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// TestDecode проверяет разбор строк протокола в типизированные точки.
+func TestDecode(t *testing.T) {
+	input := `# показания печей
+oven,line=a,zone=top temp_f=350.5 1700000000123456789
+
+cold\ room\,1,site\ name=a\=b status="ok, \"cold\"",temp_re=-4i
+room temp_k=298.15,temp_c=99 1700000000123456789
+`
+	dec := NewDecoder(strings.NewReader(input))
+
+	want := []Point{
+		{Measurement: "oven", Tags: map[string]string{"line": "a", "zone": "top"}, Temperature: tempconv.Fahrenheit(350.5), Time: t0},
+		{Measurement: "cold room,1", Tags: map[string]string{"site name": "a=b"}, Temperature: tempconv.Reaumur(-4)},
+		{Measurement: "room", Temperature: tempconv.Kelvin(298.15), Time: t0},
+	}
+	for i, w := range want {
+		p, err := dec.Decode()
+		if err != nil {
+			t.Fatalf("point %d: unexpected error: %v", i, err)
+		}
+		if p.Measurement != w.Measurement || p.Temperature != w.Temperature || !p.Time.Equal(w.Time) || len(p.Tags) != len(w.Tags) {
+			t.Errorf("point %d = %+v, want %+v", i, p, w)
+		}
+		for k, v := range w.Tags {
+			if p.Tags[k] != v {
+				t.Errorf("point %d tag %q = %q, want %q", i, k, p.Tags[k], v)
+			}
+		}
+	}
+	if _, err := dec.Decode(); err != io.EOF {
+		t.Errorf("Decode() at end error = %v, want io.EOF", err)
+	}
+}
+
+// TestDecodeErrors проверяет ошибки разбора.
+func TestDecodeErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		precision time.Duration
+		err       error
+	}{
+		{"no fields", "oven", 0, ErrSyntax},
+		{"bad tag", "oven,zone temp_c=1", 0, ErrSyntax},
+		{"bad value", "oven temp_c=hot", 0, ErrSyntax},
+		{"bad timestamp", "oven temp_c=1 yesterday", 0, ErrSyntax},
+		{"timestamp overflow", "oven temp_c=1 99999999999999", time.Second, ErrSyntax},
+		{"negative timestamp overflow", "oven temp_c=1 -9300000000000", time.Millisecond, ErrSyntax},
+		{"NaN value", "oven temp_c=NaN", 0, ErrSyntax},
+		{"infinite value", "oven temp_f=+Inf", 0, ErrSyntax},
+		{"fractional integer", "oven temp_c=1.5i", 0, ErrSyntax},
+		{"negative unsigned", "oven temp_c=-1u", 0, ErrSyntax},
+		{"no temperature field", "oven humidity=40", 0, ErrNoTemperature},
+		{"below absolute zero", "oven temp_k=-1", 0, tempconv.ErrBelowAbsoluteZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dec := NewDecoder(strings.NewReader(tt.input))
+			if tt.precision != 0 {
+				_ = dec.SetPrecision(tt.precision)
+			}
+			_, err := dec.Decode()
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Decode() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+// TestRoundTrip проверяет, что закодированные точки разбираются обратно с
+// той же точностью меток времени.
+func TestRoundTrip(t *testing.T) {
+	var b strings.Builder
+	enc := NewEncoder(&b)
+	_ = enc.SetPrecision(time.Millisecond)
+	for _, temp := range []tempconv.Temperature{tempconv.Celsius(21.25), tempconv.Delisle(100), tempconv.Newton(1.0 / 3)} {
+		if err := enc.Encode(Point{Measurement: "m", Tags: map[string]string{"id": "1"}, Temperature: temp, Time: t0}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	dec := NewDecoder(strings.NewReader(b.String()))
+	_ = dec.SetPrecision(time.Millisecond)
+	for _, want := range []tempconv.Temperature{tempconv.Celsius(21.25), tempconv.Delisle(100), tempconv.Newton(1.0 / 3)} {
+		p, err := dec.Decode()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if p.Temperature != want || !p.Time.Equal(t0.Truncate(time.Millisecond)) {
+			t.Errorf("Decode() = %v at %v, want %v at %v", p.Temperature, p.Time, want, t0.Truncate(time.Millisecond))
+		}
+	}
+}
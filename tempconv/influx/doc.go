@@ -0,0 +1,23 @@
+// Пакет influx содержит кодирование показаний температуры в текстовый
+// протокол InfluxDB (line protocol) и их разбор.
+//
+// Температура записывается в поле, имя которого определяется шкалой:
+// temp_c, temp_f, temp_k, temp_r, temp_re, temp_de, temp_n (см. FieldName).
+// При записи температура может быть преобразована в заданную шкалу, а при
+// чтении конкретный тип восстанавливается по имени поля и проверяется на
+// абсолютный ноль. Значение поля температуры должно быть конечным числом или
+// целым с суффиксом i/u; метки времени, которые при заданной точности не
+// помещаются в int64 наносекунд, отклоняются с ErrSyntax.
+//
+// # Пример использования:
+//
+//	enc := influx.NewEncoder(os.Stdout)
+//	_ = enc.SetScale(tempconv.ScaleKelvin)
+//	err := enc.Encode(influx.Point{
+//	    Measurement: "oven",
+//	    Tags:        map[string]string{"zone": "top"},
+//	    Temperature: tempconv.Celsius(180),
+//	    Time:        time.Unix(1700000000, 0),
+//	})
+//	// oven,zone=top temp_k=453.15 1700000000000000000
+package influx
@@ -0,0 +1,112 @@
+package influx
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// Экранирование специальных символов протокола
+var (
+	measurementEscaper = strings.NewReplacer(`,`, `\,`, ` `, `\ `)
+	keyEscaper         = strings.NewReplacer(`,`, `\,`, `=`, `\=`, ` `, `\ `)
+)
+
+// Encoder записывает точки в текстовом протоколе InfluxDB, по одной строке
+// на точку.
+type Encoder struct {
+	w         io.Writer
+	scale     tempconv.Scale
+	precision time.Duration
+}
+
+// NewEncoder создает кодировщик, записывающий в w. По умолчанию температура
+// записывается в собственной шкале, а метки времени - в наносекундах.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w, precision: time.Nanosecond}
+}
+
+// SetScale задает шкалу, в которую преобразуется температура при записи.
+// Нулевое значение сохраняет собственную шкалу каждой точки.
+func (e *Encoder) SetScale(s tempconv.Scale) error {
+	if s != 0 && !s.Valid() {
+		return fmt.Errorf("%w: %v", tempconv.ErrUnknownScale, s)
+	}
+	e.scale = s
+	return nil
+}
+
+// SetPrecision задает точность меток времени: time.Nanosecond,
+// time.Microsecond, time.Millisecond или time.Second.
+func (e *Encoder) SetPrecision(p time.Duration) error {
+	if err := checkPrecision(p); err != nil {
+		return err
+	}
+	e.precision = p
+	return nil
+}
+
+// Encode записывает точку p.
+func (e *Encoder) Encode(p Point) error {
+	line, err := e.appendPoint(nil, p)
+	if err != nil {
+		return err
+	}
+	_, err = e.w.Write(line)
+	return err
+}
+
+// appendPoint добавляет строку протокола для точки p к dst.
+func (e *Encoder) appendPoint(dst []byte, p Point) ([]byte, error) {
+	if p.Measurement == "" {
+		return nil, fmt.Errorf("%w: пустое имя измерения", ErrInvalidPoint)
+	}
+	if p.Temperature == nil {
+		return nil, fmt.Errorf("%w: не задана температура", ErrInvalidPoint)
+	}
+	t := p.Temperature
+	scale := tempconv.ScaleOf(t)
+	if e.scale != 0 {
+		t, scale = e.scale.Convert(t), e.scale
+	}
+	if !scale.Valid() {
+		return nil, fmt.Errorf("%w: %s", tempconv.ErrUnknownScale, t.ScaleName())
+	}
+	v := tempconv.ValueOf(t)
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil, fmt.Errorf("%w: значение %v", ErrInvalidPoint, v)
+	}
+
+	dst = append(dst, measurementEscaper.Replace(p.Measurement)...)
+	keys := make([]string, 0, len(p.Tags))
+	for k := range p.Tags {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		if k == "" || p.Tags[k] == "" {
+			return nil, fmt.Errorf("%w: пустая метка %q=%q", ErrInvalidPoint, k, p.Tags[k])
+		}
+		dst = append(dst, ',')
+		dst = append(dst, keyEscaper.Replace(k)...)
+		dst = append(dst, '=')
+		dst = append(dst, keyEscaper.Replace(p.Tags[k])...)
+	}
+
+	dst = append(dst, ' ')
+	dst = append(dst, FieldName(scale)...)
+	dst = append(dst, '=')
+	dst = strconv.AppendFloat(dst, v, 'f', -1, 64)
+
+	if !p.Time.IsZero() {
+		dst = append(dst, ' ')
+		dst = strconv.AppendInt(dst, p.Time.UnixNano()/int64(e.precision), 10)
+	}
+	return append(dst, '\n'), nil
+}
@@ -0,0 +1,124 @@
+package influx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// t0 - момент тестовых показаний
+var t0 = time.Unix(1700000000, 123456789).UTC()
+
+// TestFieldName проверяет имена полей для всех шкал.
+func TestFieldName(t *testing.T) {
+	want := map[tempconv.Scale]string{
+		tempconv.ScaleCelsius:    "temp_c",
+		tempconv.ScaleFahrenheit: "temp_f",
+		tempconv.ScaleKelvin:     "temp_k",
+		tempconv.ScaleRankine:    "temp_r",
+		tempconv.ScaleReaumur:    "temp_re",
+		tempconv.ScaleDelisle:    "temp_de",
+		tempconv.ScaleNewton:     "temp_n",
+	}
+	for s, name := range want {
+		if got := FieldName(s); got != name {
+			t.Errorf("FieldName(%v) = %q, want %q", s, got, name)
+		}
+		if got, ok := ScaleOfField(name); !ok || got != s {
+			t.Errorf("ScaleOfField(%q) = %v, %v; want %v", name, got, ok, s)
+		}
+	}
+	if got := FieldName(0); got != "" {
+		t.Errorf("FieldName(0) = %q, want empty", got)
+	}
+}
+
+// TestEncode проверяет запись точек с преобразованием шкалы и экранированием.
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name      string
+		scale     tempconv.Scale
+		precision time.Duration
+		point     Point
+		want      string
+	}{
+		{
+			name:      "own scale",
+			precision: time.Nanosecond,
+			point: Point{
+				Measurement: "oven",
+				Tags:        map[string]string{"zone": "top", "line": "a"},
+				Temperature: tempconv.Fahrenheit(350.5),
+				Time:        t0,
+			},
+			want: "oven,line=a,zone=top temp_f=350.5 1700000000123456789\n",
+		},
+		{
+			name:      "converted to Kelvin",
+			scale:     tempconv.ScaleKelvin,
+			precision: time.Second,
+			point:     Point{Measurement: "room", Temperature: tempconv.Celsius(25), Time: t0},
+			want:      "room temp_k=298.15 1700000000\n",
+		},
+		{
+			name:      "escaping without time",
+			precision: time.Millisecond,
+			point: Point{
+				Measurement: "cold room,1",
+				Tags:        map[string]string{"site name": "a=b"},
+				Temperature: tempconv.Reaumur(-4),
+			},
+			want: `cold\ room\,1,site\ name=a\=b temp_re=-4` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			enc := NewEncoder(&b)
+			if err := enc.SetScale(tt.scale); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := enc.SetPrecision(tt.precision); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := enc.Encode(tt.point); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if b.String() != tt.want {
+				t.Errorf("Encode() = %q, want %q", b.String(), tt.want)
+			}
+		})
+	}
+}
+
+// TestEncodeErrors проверяет отклонение некорректных точек и настроек.
+func TestEncodeErrors(t *testing.T) {
+	enc := NewEncoder(&strings.Builder{})
+
+	tests := []struct {
+		name  string
+		point Point
+	}{
+		{"no measurement", Point{Temperature: tempconv.Celsius(1)}},
+		{"no temperature", Point{Measurement: "m"}},
+		{"empty tag value", Point{Measurement: "m", Tags: map[string]string{"a": ""}, Temperature: tempconv.Celsius(1)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := enc.Encode(tt.point); !errors.Is(err, ErrInvalidPoint) {
+				t.Errorf("Encode() error = %v, want %v", err, ErrInvalidPoint)
+			}
+		})
+	}
+
+	if err := enc.SetPrecision(time.Minute); !errors.Is(err, ErrInvalidPrecision) {
+		t.Errorf("SetPrecision() error = %v, want %v", err, ErrInvalidPrecision)
+	}
+	if err := enc.SetScale(42); !errors.Is(err, tempconv.ErrUnknownScale) {
+		t.Errorf("SetScale() error = %v, want %v", err, tempconv.ErrUnknownScale)
+	}
+}
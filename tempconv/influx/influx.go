@@ -0,0 +1,63 @@
+package influx
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// FieldPrefix - префикс имени поля температуры.
+const FieldPrefix = "temp_"
+
+// Ошибки кодирования и разбора
+var (
+	ErrInvalidPoint     = errors.New("недопустимая точка")
+	ErrSyntax           = errors.New("ошибка синтаксиса протокола")
+	ErrNoTemperature    = errors.New("в строке нет поля температуры")
+	ErrInvalidPrecision = errors.New("недопустимая точность времени")
+)
+
+// Point - показание температуры с метками и моментом времени.
+type Point struct {
+	// Measurement - имя измерения
+	Measurement string
+	// Tags - метки точки
+	Tags map[string]string
+	// Temperature - температура
+	Temperature tempconv.Temperature
+	// Time - момент показания; нулевое значение означает отсутствие метки
+	// времени, и момент назначит сервер
+	Time time.Time
+}
+
+// FieldName возвращает имя поля температуры в шкале s, например "temp_c"
+// для шкалы Цельсия или "temp_re" для шкалы Реомюра. Для недопустимой шкалы
+// возвращается пустая строка.
+func FieldName(s tempconv.Scale) string {
+	if !s.Valid() {
+		return ""
+	}
+	return FieldPrefix + strings.ToLower(strings.TrimPrefix(s.Symbol(), "°"))
+}
+
+// ScaleOfField возвращает шкалу, соответствующую имени поля температуры.
+func ScaleOfField(name string) (tempconv.Scale, bool) {
+	for _, s := range tempconv.Scales() {
+		if FieldName(s) == name {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+// checkPrecision проверяет точность меток времени.
+func checkPrecision(p time.Duration) error {
+	if !slices.Contains([]time.Duration{time.Nanosecond, time.Microsecond, time.Millisecond, time.Second}, p) {
+		return fmt.Errorf("%w: %v", ErrInvalidPrecision, p)
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package tempconv
+
+// Двоичные представления для всех типов температур: MarshalBinary и
+// MarshalCBOR кодируют температуру в ее шкале (см. AppendBinary и
+// AppendCBOR), а UnmarshalBinary и UnmarshalCBOR принимают представление в
+// любой шкале, проверяют его на абсолютный ноль и преобразуют в шкалу
+// получателя.
+//
+// Несовместимое изменение: encoding/gob использует MarshalBinary и
+// UnmarshalBinary, поэтому типы температур записываются в gob в этом
+// представлении, а не как float64. Потоки gob, записанные до появления этих
+// методов, не разбираются в типы температур; читайте их в float64 и
+// преобразуйте явно.
+
+// MarshalBinary возвращает двоичное представление температуры в шкале Цельсия
+// (EncodingFloat64).
+func (c Celsius) MarshalBinary() ([]byte, error) { return AppendBinary(nil, c, EncodingFloat64) }
+
+// UnmarshalBinary разбирает двоичное представление температуры и
+// преобразует ее в шкалу Цельсия.
+func (c *Celsius) UnmarshalBinary(data []byte) error {
+	t, err := DecodeBinary(data)
+	if err != nil {
+		return err
+	}
+	*c = t.ToCelsius()
+	return nil
+}
+
+// MarshalCBOR возвращает представление температуры в шкале Цельсия в CBOR
+// (EncodingFloat32, если это не приводит к потере точности, иначе
+// EncodingFloat64).
+func (c Celsius) MarshalCBOR() ([]byte, error) { return defaultCBOR(c) }
+
+// UnmarshalCBOR разбирает представление температуры в CBOR и преобразует ее
+// в шкалу Цельсия.
+func (c *Celsius) UnmarshalCBOR(data []byte) error {
+	t, err := DecodeCBOR(data)
+	if err != nil {
+		return err
+	}
+	*c = t.ToCelsius()
+	return nil
+}
+
+// MarshalBinary возвращает двоичное представление температуры в шкале Фаренгейта
+// (EncodingFloat64).
+func (f Fahrenheit) MarshalBinary() ([]byte, error) { return AppendBinary(nil, f, EncodingFloat64) }
+
+// UnmarshalBinary разбирает двоичное представление температуры и
+// преобразует ее в шкалу Фаренгейта.
+func (f *Fahrenheit) UnmarshalBinary(data []byte) error {
+	t, err := DecodeBinary(data)
+	if err != nil {
+		return err
+	}
+	*f = t.ToFahrenheit()
+	return nil
+}
+
+// MarshalCBOR возвращает представление температуры в шкале Фаренгейта в CBOR
+// (EncodingFloat32, если это не приводит к потере точности, иначе
+// EncodingFloat64).
+func (f Fahrenheit) MarshalCBOR() ([]byte, error) { return defaultCBOR(f) }
+
+// UnmarshalCBOR разбирает представление температуры в CBOR и преобразует ее
+// в шкалу Фаренгейта.
+func (f *Fahrenheit) UnmarshalCBOR(data []byte) error {
+	t, err := DecodeCBOR(data)
+	if err != nil {
+		return err
+	}
+	*f = t.ToFahrenheit()
+	return nil
+}
+
+// MarshalBinary возвращает двоичное представление температуры в шкале Кельвина
+// (EncodingFloat64).
+func (k Kelvin) MarshalBinary() ([]byte, error) { return AppendBinary(nil, k, EncodingFloat64) }
+
+// UnmarshalBinary разбирает двоичное представление температуры и
+// преобразует ее в шкалу Кельвина.
+func (k *Kelvin) UnmarshalBinary(data []byte) error {
+	t, err := DecodeBinary(data)
+	if err != nil {
+		return err
+	}
+	*k = t.ToKelvin()
+	return nil
+}
+
+// MarshalCBOR возвращает представление температуры в шкале Кельвина в CBOR
+// (EncodingFloat32, если это не приводит к потере точности, иначе
+// EncodingFloat64).
+func (k Kelvin) MarshalCBOR() ([]byte, error) { return defaultCBOR(k) }
+
+// UnmarshalCBOR разбирает представление температуры в CBOR и преобразует ее
+// в шкалу Кельвина.
+func (k *Kelvin) UnmarshalCBOR(data []byte) error {
+	t, err := DecodeCBOR(data)
+	if err != nil {
+		return err
+	}
+	*k = t.ToKelvin()
+	return nil
+}
+
+// MarshalBinary возвращает двоичное представление температуры в шкале Ранкина
+// (EncodingFloat64).
+func (r Rankine) MarshalBinary() ([]byte, error) { return AppendBinary(nil, r, EncodingFloat64) }
+
+// UnmarshalBinary разбирает двоичное представление температуры и
+// преобразует ее в шкалу Ранкина.
+func (r *Rankine) UnmarshalBinary(data []byte) error {
+	t, err := DecodeBinary(data)
+	if err != nil {
+		return err
+	}
+	*r = t.ToRankine()
+	return nil
+}
+
+// MarshalCBOR возвращает представление температуры в шкале Ранкина в CBOR
+// (EncodingFloat32, если это не приводит к потере точности, иначе
+// EncodingFloat64).
+func (r Rankine) MarshalCBOR() ([]byte, error) { return defaultCBOR(r) }
+
+// UnmarshalCBOR разбирает представление температуры в CBOR и преобразует ее
+// в шкалу Ранкина.
+func (r *Rankine) UnmarshalCBOR(data []byte) error {
+	t, err := DecodeCBOR(data)
+	if err != nil {
+		return err
+	}
+	*r = t.ToRankine()
+	return nil
+}
+
+// MarshalBinary возвращает двоичное представление температуры в шкале Реомюра
+// (EncodingFloat64).
+func (re Reaumur) MarshalBinary() ([]byte, error) { return AppendBinary(nil, re, EncodingFloat64) }
+
+// UnmarshalBinary разбирает двоичное представление температуры и
+// преобразует ее в шкалу Реомюра.
+func (re *Reaumur) UnmarshalBinary(data []byte) error {
+	t, err := DecodeBinary(data)
+	if err != nil {
+		return err
+	}
+	*re = t.ToReaumur()
+	return nil
+}
+
+// MarshalCBOR возвращает представление температуры в шкале Реомюра в CBOR
+// (EncodingFloat32, если это не приводит к потере точности, иначе
+// EncodingFloat64).
+func (re Reaumur) MarshalCBOR() ([]byte, error) { return defaultCBOR(re) }
+
+// UnmarshalCBOR разбирает представление температуры в CBOR и преобразует ее
+// в шкалу Реомюра.
+func (re *Reaumur) UnmarshalCBOR(data []byte) error {
+	t, err := DecodeCBOR(data)
+	if err != nil {
+		return err
+	}
+	*re = t.ToReaumur()
+	return nil
+}
+
+// MarshalBinary возвращает двоичное представление температуры в шкале Делисля
+// (EncodingFloat64).
+func (de Delisle) MarshalBinary() ([]byte, error) { return AppendBinary(nil, de, EncodingFloat64) }
+
+// UnmarshalBinary разбирает двоичное представление температуры и
+// преобразует ее в шкалу Делисля.
+func (de *Delisle) UnmarshalBinary(data []byte) error {
+	t, err := DecodeBinary(data)
+	if err != nil {
+		return err
+	}
+	*de = t.ToDelisle()
+	return nil
+}
+
+// MarshalCBOR возвращает представление температуры в шкале Делисля в CBOR
+// (EncodingFloat32, если это не приводит к потере точности, иначе
+// EncodingFloat64).
+func (de Delisle) MarshalCBOR() ([]byte, error) { return defaultCBOR(de) }
+
+// UnmarshalCBOR разбирает представление температуры в CBOR и преобразует ее
+// в шкалу Делисля.
+func (de *Delisle) UnmarshalCBOR(data []byte) error {
+	t, err := DecodeCBOR(data)
+	if err != nil {
+		return err
+	}
+	*de = t.ToDelisle()
+	return nil
+}
+
+// MarshalBinary возвращает двоичное представление температуры в шкале Ньютона
+// (EncodingFloat64).
+func (n Newton) MarshalBinary() ([]byte, error) { return AppendBinary(nil, n, EncodingFloat64) }
+
+// UnmarshalBinary разбирает двоичное представление температуры и
+// преобразует ее в шкалу Ньютона.
+func (n *Newton) UnmarshalBinary(data []byte) error {
+	t, err := DecodeBinary(data)
+	if err != nil {
+		return err
+	}
+	*n = t.ToNewton()
+	return nil
+}
+
+// MarshalCBOR возвращает представление температуры в шкале Ньютона в CBOR
+// (EncodingFloat32, если это не приводит к потере точности, иначе
+// EncodingFloat64).
+func (n Newton) MarshalCBOR() ([]byte, error) { return defaultCBOR(n) }
+
+// UnmarshalCBOR разбирает представление температуры в CBOR и преобразует ее
+// в шкалу Ньютона.
+func (n *Newton) UnmarshalCBOR(data []byte) error {
+	t, err := DecodeCBOR(data)
+	if err != nil {
+		return err
+	}
+	*n = t.ToNewton()
+	return nil
+}
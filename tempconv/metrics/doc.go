@@ -0,0 +1,26 @@
+// Пакет metrics содержит метрики температуры в формате Prometheus без
+// зависимости от клиентской библиотеки.
+//
+// По соглашению Prometheus температура выражается в базовой единице -
+// градусах Цельсия, а имя метрики оканчивается суффиксом _celsius. Пакет
+// преобразует показания в любых шкалах через ToCelsius, добавляет суффикс к
+// именам без него и отклоняет имена с суффиксами других шкал
+// (_fahrenheit, _kelvin и т.д.), которые обычно означают, что значение не
+// было преобразовано.
+//
+// Registry хранит датчики (Gauge) и гистограммы (Histogram) и выводит их в
+// текстовом формате экспозиции Prometheus (WriteText, Handler), что подходит
+// для встроенных систем. Программы, использующие клиентскую библиотеку,
+// могут применять только MetricName и Value.
+//
+// # Пример использования:
+//
+//	reg := metrics.NewRegistry()
+//	g, err := reg.NewGauge("oven_temperature", "Температура в печи.", "oven")
+//	if err != nil {
+//	    fmt.Println("Ошибка:", err)
+//	    return
+//	}
+//	_ = g.Set(tempconv.Fahrenheit(350), "reflow-1")
+//	http.Handle("/metrics", reg.Handler())
+package metrics
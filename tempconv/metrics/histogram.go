@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// Histogram - гистограмма показаний температуры с набором меток. Границы
+// корзин хранятся в градусах Цельсия.
+type Histogram struct {
+	name, help string
+	labels     []string
+	bounds     []float64
+
+	mu     sync.Mutex
+	series map[string]*histogramSeries
+}
+
+// histogramSeries - гистограмма для конкретных значений меток.
+type histogramSeries struct {
+	labels []string
+	counts []uint64 // число наблюдений в каждой корзине (не накопленное)
+	count  uint64
+	sum    float64
+}
+
+// NewHistogram регистрирует гистограмму с именем name, описанием help,
+// верхними границами корзин buckets (в любых шкалах) и именами меток labels.
+// Корзина +Inf добавляется автоматически.
+func (r *Registry) NewHistogram(name, help string, buckets []tempconv.Temperature, labels ...string) (*Histogram, error) {
+	if len(buckets) == 0 {
+		return nil, fmt.Errorf("%w: нет границ", ErrInvalidBuckets)
+	}
+	bounds := make([]float64, len(buckets))
+	for i, b := range buckets {
+		if b == nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidBuckets, ErrNilTemperature)
+		}
+		bounds[i] = Value(b)
+		if math.IsNaN(bounds[i]) {
+			return nil, fmt.Errorf("%w: NaN", ErrInvalidBuckets)
+		}
+	}
+	slices.Sort(bounds)
+	if len(slices.Compact(slices.Clone(bounds))) != len(bounds) {
+		return nil, fmt.Errorf("%w: повторяющиеся границы", ErrInvalidBuckets)
+	}
+	if math.IsInf(bounds[len(bounds)-1], 1) {
+		bounds = bounds[:len(bounds)-1]
+	}
+
+	var h *Histogram
+	err := r.register(name, labels, "le", func(name string) collector {
+		h = &Histogram{
+			name: name, help: help, labels: slices.Clone(labels), bounds: bounds,
+			series: make(map[string]*histogramSeries),
+		}
+		return h
+	})
+	if err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// LinearBuckets возвращает count границ корзин, начиная с start с шагом
+// width. Границы возвращаются в шкале start.
+func LinearBuckets(start tempconv.Temperature, width tempconv.Delta, count int) []tempconv.Temperature {
+	buckets := make([]tempconv.Temperature, count)
+	for i := range buckets {
+		buckets[i] = tempconv.Delta{Value: width.Value * float64(i), Scale: width.Scale}.Add(start)
+	}
+	return buckets
+}
+
+// Name возвращает имя метрики с суффиксом _celsius.
+func (h *Histogram) Name() string { return h.name }
+
+// Observe добавляет показание t для значений меток labelValues.
+func (h *Histogram) Observe(t tempconv.Temperature, labelValues ...string) error {
+	if t == nil {
+		return ErrNilTemperature
+	}
+	if len(labelValues) != len(h.labels) {
+		return fmt.Errorf("%w: %d вместо %d", ErrLabelCount, len(labelValues), len(h.labels))
+	}
+	v := Value(t)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	key := labelKey(labelValues)
+	s, ok := h.series[key]
+	if !ok {
+		s = &histogramSeries{labels: slices.Clone(labelValues), counts: make([]uint64, len(h.bounds))}
+		h.series[key] = s
+	}
+	if i := sort.SearchFloat64s(h.bounds, v); i < len(h.bounds) {
+		s.counts[i]++
+	}
+	s.count++
+	s.sum += v
+	return nil
+}
+
+// write выводит гистограмму в текстовом формате.
+func (h *Histogram) write(w *bufio.Writer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	writeHeader(w, h.name, h.help, "histogram")
+	for _, key := range sortedKeys(h.series) {
+		s := h.series[key]
+		var cumulative uint64
+		for i, b := range h.bounds {
+			cumulative += s.counts[i]
+			writeSample(w, h.name+"_bucket", h.labels, s.labels, "le", formatFloat(b), float64(cumulative))
+		}
+		writeSample(w, h.name+"_bucket", h.labels, s.labels, "le", "+Inf", float64(s.count))
+		writeSample(w, h.name+"_sum", h.labels, s.labels, "", "", s.sum)
+		writeSample(w, h.name+"_count", h.labels, s.labels, "", "", float64(s.count))
+	}
+}
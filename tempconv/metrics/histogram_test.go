@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// TestHistogram проверяет накопленные корзины, сумму и количество.
+func TestHistogram(t *testing.T) {
+	reg := NewRegistry()
+	buckets := LinearBuckets(tempconv.Fahrenheit(32), tempconv.Delta{Value: 18, Scale: tempconv.ScaleFahrenheit}, 3)
+	h, err := reg.NewHistogram("water_temperature", "", buckets, "tank")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, temp := range []tempconv.Temperature{
+		tempconv.Celsius(-5),
+		tempconv.Celsius(10), // на границе корзины
+		tempconv.Kelvin(288.15),
+		tempconv.Celsius(50),
+	} {
+		if err := h.Observe(temp, "a"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	var b strings.Builder
+	_ = reg.WriteText(&b)
+	want := `# TYPE water_temperature_celsius histogram
+water_temperature_celsius_bucket{tank="a",le="0"} 1
+water_temperature_celsius_bucket{tank="a",le="10"} 2
+water_temperature_celsius_bucket{tank="a",le="20"} 3
+water_temperature_celsius_bucket{tank="a",le="+Inf"} 4
+water_temperature_celsius_sum{tank="a"} 70
+water_temperature_celsius_count{tank="a"} 4
+`
+	if b.String() != want {
+		t.Errorf("WriteText() =\n%s\nwant\n%s", b.String(), want)
+	}
+}
+
+// TestHistogramBuckets проверяет упорядочивание границ в разных шкалах и
+// отклонение некорректных границ.
+func TestHistogramBuckets(t *testing.T) {
+	reg := NewRegistry()
+	h, err := reg.NewHistogram("mixed", "", []tempconv.Temperature{
+		tempconv.Kelvin(373.15), tempconv.Celsius(0), tempconv.Delisle(75), tempconv.Celsius(math.Inf(1)),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []float64{0, 50, 100}; len(h.bounds) != len(want) || h.bounds[0] != 0 || h.bounds[1] != 50 || h.bounds[2] != 100 {
+		t.Errorf("bounds = %v, want %v", h.bounds, want)
+	}
+
+	tests := []struct {
+		name    string
+		buckets []tempconv.Temperature
+		labels  []string
+		err     error
+	}{
+		{"no buckets", nil, nil, ErrInvalidBuckets},
+		{"duplicate", []tempconv.Temperature{tempconv.Celsius(0), tempconv.Kelvin(273.15)}, nil, ErrInvalidBuckets},
+		{"nil bucket", []tempconv.Temperature{nil}, nil, ErrInvalidBuckets},
+		{"reserved label", []tempconv.Temperature{tempconv.Celsius(0)}, []string{"le"}, ErrInvalidName},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := reg.NewHistogram("h_"+strings.ReplaceAll(tt.name, " ", "_"), "", tt.buckets, tt.labels...); !errors.Is(err, tt.err) {
+				t.Errorf("NewHistogram() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package metrics
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// Suffix - суффикс имени метрики температуры в базовой единице Prometheus.
+const Suffix = "_celsius"
+
+// ContentType - тип содержимого текстового формата экспозиции.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Ошибки метрик
+var (
+	ErrInvalidName    = errors.New("недопустимое имя метрики")
+	ErrDuplicate      = errors.New("метрика уже зарегистрирована")
+	ErrLabelCount     = errors.New("неверное число значений меток")
+	ErrNilTemperature = errors.New("не задана температура")
+	ErrInvalidBuckets = errors.New("недопустимые границы гистограммы")
+)
+
+// foreignSuffixes - суффиксы единиц других шкал, недопустимые в именах метрик.
+var foreignSuffixes = []string{
+	"_fahrenheit", "_kelvin", "_kelvins", "_rankine", "_reaumur", "_delisle", "_newton",
+}
+
+// MetricName проверяет имя метрики температуры и при необходимости добавляет
+// суффикс _celsius. Имена с суффиксами других шкал отклоняются.
+func MetricName(name string) (string, error) {
+	if !validName(name, true) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidName, name)
+	}
+	for _, s := range foreignSuffixes {
+		if strings.HasSuffix(name, s) {
+			return "", fmt.Errorf("%w: %q: суффикс %s вместо %s", ErrInvalidName, name, s, Suffix)
+		}
+	}
+	if !strings.HasSuffix(name, Suffix) {
+		name += Suffix
+	}
+	return name, nil
+}
+
+// Value возвращает значение температуры t в градусах Цельсия.
+func Value(t tempconv.Temperature) float64 { return float64(t.ToCelsius()) }
+
+// collector - метрика, которую можно вывести в текстовом формате.
+type collector interface {
+	write(w *bufio.Writer)
+}
+
+// Registry - набор метрик температуры. Методы Registry и метрик безопасны для
+// одновременного использования из нескольких горутин.
+type Registry struct {
+	mu      sync.Mutex
+	metrics map[string]collector
+}
+
+// NewRegistry создает пустой набор метрик.
+func NewRegistry() *Registry {
+	return &Registry{metrics: make(map[string]collector)}
+}
+
+// register добавляет метрику с именем name после проверки имени и меток.
+// Метка reserved зарезервирована видом метрики (le у гистограмм).
+func (r *Registry) register(name string, labels []string, reserved string, newMetric func(name string) collector) error {
+	name, err := MetricName(name)
+	if err != nil {
+		return err
+	}
+	for _, l := range labels {
+		if !validName(l, false) || strings.HasPrefix(l, "__") || l == reserved {
+			return fmt.Errorf("%w: метка %q", ErrInvalidName, l)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.metrics[name]; ok {
+		return fmt.Errorf("%w: %s", ErrDuplicate, name)
+	}
+	r.metrics[name] = newMetric(name)
+	return nil
+}
+
+// WriteText выводит все метрики в текстовом формате экспозиции Prometheus,
+// упорядочивая их по имени.
+func (r *Registry) WriteText(w io.Writer) error {
+	r.mu.Lock()
+	names := make([]string, 0, len(r.metrics))
+	for name := range r.metrics {
+		names = append(names, name)
+	}
+	collectors := make([]collector, 0, len(names))
+	slices.Sort(names)
+	for _, name := range names {
+		collectors = append(collectors, r.metrics[name])
+	}
+	r.mu.Unlock()
+
+	bw := bufio.NewWriter(w)
+	for _, c := range collectors {
+		c.write(bw)
+	}
+	return bw.Flush()
+}
+
+// Handler возвращает HTTP-обработчик, выводящий метрики в текстовом формате.
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", ContentType)
+		_ = r.WriteText(w)
+	})
+}
+
+// Gauge - датчик температуры с набором меток.
+type Gauge struct {
+	name, help string
+	labels     []string
+
+	mu     sync.Mutex
+	values map[string]sample
+}
+
+// sample - значение метрики с конкретными значениями меток.
+type sample struct {
+	labels []string
+	value  float64
+}
+
+// NewGauge регистрирует датчик температуры с именем name, описанием help и
+// именами меток labels.
+func (r *Registry) NewGauge(name, help string, labels ...string) (*Gauge, error) {
+	var g *Gauge
+	err := r.register(name, labels, "", func(name string) collector {
+		g = &Gauge{name: name, help: help, labels: slices.Clone(labels), values: make(map[string]sample)}
+		return g
+	})
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// Name возвращает имя метрики с суффиксом _celsius.
+func (g *Gauge) Name() string { return g.name }
+
+// Set устанавливает значение датчика для значений меток labelValues.
+func (g *Gauge) Set(t tempconv.Temperature, labelValues ...string) error {
+	if t == nil {
+		return ErrNilTemperature
+	}
+	if len(labelValues) != len(g.labels) {
+		return fmt.Errorf("%w: %d вместо %d", ErrLabelCount, len(labelValues), len(g.labels))
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.values[labelKey(labelValues)] = sample{labels: slices.Clone(labelValues), value: Value(t)}
+	return nil
+}
+
+// Delete удаляет значение датчика для значений меток labelValues.
+func (g *Gauge) Delete(labelValues ...string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.values, labelKey(labelValues))
+}
+
+// write выводит датчик в текстовом формате.
+func (g *Gauge) write(w *bufio.Writer) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	writeHeader(w, g.name, g.help, "gauge")
+	for _, key := range sortedKeys(g.values) {
+		s := g.values[key]
+		writeSample(w, g.name, g.labels, s.labels, "", "", s.value)
+	}
+}
+
+// writeHeader выводит строки HELP и TYPE метрики.
+func writeHeader(w *bufio.Writer, name, help, typ string) {
+	if help != "" {
+		fmt.Fprintf(w, "# HELP %s %s\n", name, escapeHelp(help))
+	}
+	fmt.Fprintf(w, "# TYPE %s %s\n", name, typ)
+}
+
+// writeSample выводит строку значения метрики. Дополнительная метка extra
+// (например, le у гистограмм) выводится последней, если задана.
+func writeSample(w *bufio.Writer, name string, labels, values []string, extra, extraValue string, v float64) {
+	w.WriteString(name)
+	if len(labels) > 0 || extra != "" {
+		w.WriteByte('{')
+		for i, l := range labels {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			fmt.Fprintf(w, "%s=\"%s\"", l, escapeLabel(values[i]))
+		}
+		if extra != "" {
+			if len(labels) > 0 {
+				w.WriteByte(',')
+			}
+			fmt.Fprintf(w, "%s=\"%s\"", extra, extraValue)
+		}
+		w.WriteByte('}')
+	}
+	w.WriteByte(' ')
+	w.WriteString(formatFloat(v))
+	w.WriteByte('\n')
+}
+
+// formatFloat форматирует число так, как того требует формат экспозиции.
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// escapeHelp экранирует текст описания метрики.
+func escapeHelp(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(s)
+}
+
+// escapeLabel экранирует значение метки.
+func escapeLabel(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`).Replace(s)
+}
+
+// labelKey возвращает ключ набора значений меток.
+func labelKey(values []string) string { return strings.Join(values, "\xff") }
+
+// sortedKeys возвращает ключи отображения в порядке возрастания.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// validName проверяет имя метрики (colon = true) или метки (colon = false).
+func validName(s string, colon bool) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z':
+		case r >= '0' && r <= '9' && i > 0:
+		case r == ':' && colon:
+		default:
+			return false
+		}
+	}
+	return true
+}
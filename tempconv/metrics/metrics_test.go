@@ -0,0 +1,124 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// TestMetricName проверяет проверку и нормализацию имен метрик.
+func TestMetricName(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		err      error
+	}{
+		{"oven_temperature", "oven_temperature_celsius", nil},
+		{"oven_temperature_celsius", "oven_temperature_celsius", nil},
+		{"node:cpu_temp", "node:cpu_temp_celsius", nil},
+		{"oven_temperature_fahrenheit", "", ErrInvalidName},
+		{"core_kelvin", "", ErrInvalidName},
+		{"1st_sensor", "", ErrInvalidName},
+		{"bad-name", "", ErrInvalidName},
+		{"", "", ErrInvalidName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := MetricName(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("MetricName() error = %v, want %v", err, tt.err)
+			}
+			if got != tt.expected {
+				t.Errorf("MetricName() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestGauge проверяет преобразование показаний в градусы Цельсия и вывод
+// датчика в текстовом формате.
+func TestGauge(t *testing.T) {
+	reg := NewRegistry()
+	g, err := reg.NewGauge("oven_temperature", "Температура в печи.\nПо зонам.", "oven", "zone")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.Name() != "oven_temperature_celsius" {
+		t.Errorf("Name() = %q, want oven_temperature_celsius", g.Name())
+	}
+
+	inputs := []struct {
+		temp   tempconv.Temperature
+		labels []string
+	}{
+		{tempconv.Fahrenheit(212), []string{"reflow-2", "top"}},
+		{tempconv.Kelvin(473.15), []string{"reflow-1", `"a"\b`}},
+		{tempconv.Delisle(0), []string{"reflow-1", "bottom"}},
+	}
+	for _, in := range inputs {
+		if err := g.Set(in.temp, in.labels...); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	var b strings.Builder
+	if err := reg.WriteText(&b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `# HELP oven_temperature_celsius Температура в печи.\nПо зонам.
+# TYPE oven_temperature_celsius gauge
+oven_temperature_celsius{oven="reflow-1",zone="\"a\"\\b"} 200
+oven_temperature_celsius{oven="reflow-1",zone="bottom"} 100
+oven_temperature_celsius{oven="reflow-2",zone="top"} 100
+`
+	if b.String() != want {
+		t.Errorf("WriteText() =\n%s\nwant\n%s", b.String(), want)
+	}
+
+	g.Delete("reflow-1", "bottom")
+	b.Reset()
+	_ = reg.WriteText(&b)
+	if strings.Contains(b.String(), "bottom") {
+		t.Errorf("deleted series still exported:\n%s", b.String())
+	}
+}
+
+// TestRegistryErrors проверяет ошибки регистрации и обновления метрик.
+func TestRegistryErrors(t *testing.T) {
+	reg := NewRegistry()
+	g, _ := reg.NewGauge("room", "", "sensor")
+
+	if _, err := reg.NewGauge("room_celsius", ""); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("NewGauge() error = %v, want %v", err, ErrDuplicate)
+	}
+	if _, err := reg.NewGauge("probe", "", "__name"); !errors.Is(err, ErrInvalidName) {
+		t.Errorf("NewGauge() error = %v, want %v", err, ErrInvalidName)
+	}
+	if err := g.Set(tempconv.Celsius(20)); !errors.Is(err, ErrLabelCount) {
+		t.Errorf("Set() error = %v, want %v", err, ErrLabelCount)
+	}
+	if err := g.Set(nil, "a"); !errors.Is(err, ErrNilTemperature) {
+		t.Errorf("Set() error = %v, want %v", err, ErrNilTemperature)
+	}
+}
+
+// TestHandler проверяет HTTP-обработчик экспозиции.
+func TestHandler(t *testing.T) {
+	reg := NewRegistry()
+	g, _ := reg.NewGauge("room", "")
+	_ = g.Set(tempconv.Celsius(21.5))
+
+	rec := httptest.NewRecorder()
+	reg.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if ct := rec.Header().Get("Content-Type"); ct != ContentType {
+		t.Errorf("Content-Type = %q, want %q", ct, ContentType)
+	}
+	if want := "# TYPE room_celsius gauge\nroom_celsius 21.5\n"; rec.Body.String() != want {
+		t.Errorf("body = %q, want %q", rec.Body.String(), want)
+	}
+}
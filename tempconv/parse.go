@@ -0,0 +1,66 @@
+package tempconv
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ParseTemperature разбирает строку вида "25.00 C", "85°C", "-40F" или
+// "300 kelvin": число, за которым следует обозначение шкалы, допустимое для
+// ParseScale. Пробелы между числом и шкалой не обязательны. Температура
+// создается с проверкой абсолютного нуля; бесконечные значения и числа вне
+// диапазона float64 отклоняются с ErrInvalidFormat.
+func ParseTemperature(s string) (Temperature, error) {
+	v, scale, err := splitTemperature(s)
+	if err != nil {
+		return nil, err
+	}
+	return scale.New(v)
+}
+
+// ParseTemperatureIn разбирает строку с обозначением шкалы так же, как
+// ParseTemperature, а число без обозначения шкалы ("85", "-40.5") - как
+// температуру в шкале def. NaN и бесконечности ("Inf", "+Inf", "-Infinity")
+// отклоняются с ErrInvalidFormat.
+func ParseTemperatureIn(s string, def Scale) (Temperature, error) {
+	str := strings.TrimSpace(s)
+	v, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return ParseTemperature(str)
+	}
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+	return def.New(v)
+}
+
+// FormatTemperature возвращает текстовое представление температуры t,
+// которое ParseTemperature разбирает без потери точности, например "25 C" или
+// "36.6 Re".
+func FormatTemperature(t Temperature) string {
+	s := ScaleOf(t)
+	return strconv.FormatFloat(ValueOf(t), 'f', -1, 64) + " " + strings.TrimPrefix(s.Symbol(), "°")
+}
+
+// splitTemperature разделяет строку на число и шкалу.
+func splitTemperature(s string) (float64, Scale, error) {
+	str := strings.TrimSpace(s)
+	i := strings.LastIndexFunc(str, func(r rune) bool { return r >= '0' && r <= '9' || r == '.' })
+	if i < 0 {
+		return 0, 0, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+	num, unit := str[:i+1], strings.TrimLeftFunc(str[i+1:], unicode.IsSpace)
+
+	v, err := strconv.ParseFloat(num, 64)
+	if err != nil || math.IsInf(v, 0) {
+		return 0, 0, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+	scale, err := ParseScale(unit)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %q: %w", ErrInvalidFormat, s, err)
+	}
+	return v, scale, nil
+}
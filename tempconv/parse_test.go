@@ -0,0 +1,112 @@
+package tempconv
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestParseTemperature проверяет разбор температур со шкалой.
+func TestParseTemperature(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Temperature
+		err      error
+	}{
+		{"25.00 C", Celsius(25), nil},
+		{"85°C", Celsius(85), nil},
+		{"-40F", Fahrenheit(-40), nil},
+		{" 300 kelvin ", Kelvin(300), nil},
+		{"491.67 R", Rankine(491.67), nil},
+		{"1.5e2 Re", Reaumur(150), nil},
+		{"0 °De", Delisle(0), nil},
+		{"+33N", Newton(33), nil},
+		{"-300 C", nil, ErrBelowAbsoluteZero},
+		{"600 De", nil, ErrBelowAbsoluteZero},
+		{"25", nil, ErrInvalidFormat},
+		{"25 X", nil, ErrUnknownScale},
+		{"C", nil, ErrInvalidFormat},
+		{"1.2.3 C", nil, ErrInvalidFormat},
+		{"1e999 C", nil, ErrInvalidFormat},
+		{"Inf C", nil, ErrInvalidFormat},
+		{"", nil, ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseTemperature(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if err == nil && got != tt.expected {
+				t.Fatalf("expected %v (%s), got %v (%s)", tt.expected, tt.expected.ScaleName(), got, got.ScaleName())
+			}
+		})
+	}
+}
+
+// TestParseTemperatureIn проверяет разбор чисел без шкалы в шкале по умолчанию.
+func TestParseTemperatureIn(t *testing.T) {
+	tests := []struct {
+		input    string
+		def      Scale
+		expected Temperature
+		err      error
+	}{
+		{"25", ScaleKelvin, Kelvin(25), nil},
+		{" -40.5 ", ScaleFahrenheit, Fahrenheit(-40.5), nil},
+		{"72F", ScaleCelsius, Fahrenheit(72), nil},
+		{"-1", ScaleKelvin, nil, ErrBelowAbsoluteZero},
+		{"NaN", ScaleCelsius, nil, ErrInvalidFormat},
+		{"Inf", ScaleFahrenheit, nil, ErrInvalidFormat},
+		{"+Inf", ScaleCelsius, nil, ErrInvalidFormat},
+		{"-Infinity", ScaleCelsius, nil, ErrInvalidFormat},
+		{"1e999", ScaleKelvin, nil, ErrInvalidFormat},
+		{"25", 0, nil, ErrUnknownScale},
+		{"", ScaleCelsius, nil, ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseTemperatureIn(tt.input, tt.def)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if err == nil && got != tt.expected {
+				t.Fatalf("expected %v (%s), got %v (%s)", tt.expected, tt.expected.ScaleName(), got, got.ScaleName())
+			}
+		})
+	}
+}
+
+// TestFormatTemperature проверяет, что FormatTemperature и ParseTemperature
+// взаимно обратны для всех шкал.
+func TestFormatTemperature(t *testing.T) {
+	tests := []struct {
+		input    Temperature
+		expected string
+	}{
+		{Celsius(25), "25 C"},
+		{Fahrenheit(98.6), "98.6 F"},
+		{Kelvin(0.1), "0.1 K"},
+		{Rankine(500), "500 R"},
+		{Reaumur(-12.25), "-12.25 Re"},
+		{Delisle(150), "150 De"},
+		{Newton(1.0 / 3), "0.3333333333333333 N"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			s := FormatTemperature(tt.input)
+			if s != tt.expected {
+				t.Fatalf("expected %q, got %q", tt.expected, s)
+			}
+			back, err := ParseTemperature(s)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if back != tt.input {
+				t.Fatalf("round trip: expected %v, got %v", tt.input, back)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+// Пакет radiation содержит функции теплового излучения абсолютно черного и
+// серого тел для температур, заданных типом tempconv.Kelvin.
+//
+// # Возможности:
+//
+// - SpectralRadiance      — спектральная энергетическая яркость по закону Планка,
+//
+// - PeakWavelength        — длина волны максимума излучения по закону смещения Вина,
+//
+// - Exitance              — энергетическая светимость по закону Стефана-Больцмана,
+//
+// - TemperatureFromExitance — температура по измеренной светимости,
+//
+// - BrightnessTemperature — яркостная температура по спектральной яркости,
+//
+// - RadianceTemperature   — истинная температура с поправкой на излучательную
+// способность и отраженное излучение окружения (пирометрия, ИК-камеры).
+//
+// Длины волн задаются в метрах, спектральная яркость - в Вт/(м²·ср·м),
+// светимость - в Вт/м². Результат в кельвинах можно перевести в любую шкалу
+// методами ToCelsius, ToFahrenheit и т.д.
+//
+// # Пример использования:
+//
+//	k, err := radiation.RadianceTemperature(l, 10e-6, 0.95, tempconv.Celsius(20))
+//	if err != nil {
+//	    fmt.Println("Ошибка:", err)
+//	    return
+//	}
+//	fmt.Println(k.ToCelsius())
+package radiation
@@ -0,0 +1,153 @@
+package radiation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// Ошибки расчетов теплового излучения
+var (
+	ErrInvalidWavelength = errors.New("недопустимая длина волны")
+	ErrInvalidEmissivity = errors.New("излучательная способность вне диапазона (0, 1]")
+	ErrInvalidRadiance   = errors.New("недопустимая энергетическая яркость")
+)
+
+// Физические константы (CODATA 2018)
+const (
+	// Planck - постоянная Планка, Дж·с
+	Planck = 6.62607015e-34
+	// SpeedOfLight - скорость света в вакууме, м/с
+	SpeedOfLight = 299792458.0
+	// Boltzmann - постоянная Больцмана, Дж/К
+	Boltzmann = 1.380649e-23
+	// StefanBoltzmann - постоянная Стефана-Больцмана, Вт/(м²·К⁴)
+	StefanBoltzmann = 5.670374419e-8
+	// WienDisplacement - постоянная смещения Вина, м·К
+	WienDisplacement = 2.897771955e-3
+
+	// firstRadiation - первая радиационная постоянная для яркости 2hc², Вт·м²/ср
+	firstRadiation = 2 * Planck * SpeedOfLight * SpeedOfLight
+	// secondRadiation - вторая радиационная постоянная hc/k, м·К
+	secondRadiation = Planck * SpeedOfLight / Boltzmann
+)
+
+// SpectralRadiance возвращает спектральную энергетическую яркость абсолютно
+// черного тела с температурой k на длине волны wavelength (м) по закону
+// Планка, Вт/(м²·ср·м).
+func SpectralRadiance(k tempconv.Kelvin, wavelength float64) (float64, error) {
+	if err := validateWavelength(wavelength); err != nil {
+		return 0, err
+	}
+	if _, err := tempconv.NewKelvin(float64(k)); err != nil {
+		return 0, err
+	}
+	return planck(float64(k), wavelength), nil
+}
+
+// PeakWavelength возвращает длину волны (м), на которую приходится максимум
+// излучения абсолютно черного тела с температурой k (закон смещения Вина).
+func PeakWavelength(k tempconv.Kelvin) (float64, error) {
+	if !(k > 0) {
+		return 0, fmt.Errorf("%w: %v", tempconv.ErrBelowAbsoluteZero, k)
+	}
+	return WienDisplacement / float64(k), nil
+}
+
+// Exitance возвращает энергетическую светимость серого тела с температурой k
+// и излучательной способностью emissivity по закону Стефана-Больцмана, Вт/м².
+// Для абсолютно черного тела emissivity равна 1.
+func Exitance(k tempconv.Kelvin, emissivity float64) (float64, error) {
+	if err := validateEmissivity(emissivity); err != nil {
+		return 0, err
+	}
+	if _, err := tempconv.NewKelvin(float64(k)); err != nil {
+		return 0, err
+	}
+	return emissivity * StefanBoltzmann * math.Pow(float64(k), 4), nil
+}
+
+// TemperatureFromExitance возвращает температуру серого тела с излучательной
+// способностью emissivity по его энергетической светимости exitance (Вт/м²).
+func TemperatureFromExitance(exitance, emissivity float64) (tempconv.Kelvin, error) {
+	if err := validateEmissivity(emissivity); err != nil {
+		return 0, err
+	}
+	if !(exitance >= 0) || math.IsInf(exitance, 1) {
+		return 0, fmt.Errorf("%w: %v Вт/м²", ErrInvalidRadiance, exitance)
+	}
+	return tempconv.Kelvin(math.Pow(exitance/(emissivity*StefanBoltzmann), 0.25)), nil
+}
+
+// BrightnessTemperature возвращает яркостную температуру - температуру
+// абсолютно черного тела с той же спектральной яркостью radiance
+// (Вт/(м²·ср·м)) на длине волны wavelength (м).
+func BrightnessTemperature(radiance, wavelength float64) (tempconv.Kelvin, error) {
+	if err := validateWavelength(wavelength); err != nil {
+		return 0, err
+	}
+	if !(radiance > 0) || math.IsInf(radiance, 1) {
+		return 0, fmt.Errorf("%w: %v Вт/(м²·ср·м)", ErrInvalidRadiance, radiance)
+	}
+	return tempconv.Kelvin(inversePlanck(radiance, wavelength)), nil
+}
+
+// RadianceTemperature возвращает истинную температуру объекта по измеренной
+// спектральной яркости radiance на длине волны wavelength с учетом
+// излучательной способности объекта emissivity и отраженного излучения
+// окружения с температурой ambient:
+//
+//	L = ε·B(T) + (1 - ε)·B(Tamb)
+//
+// Если ambient равна nil, отраженное излучение не учитывается.
+func RadianceTemperature(radiance, wavelength, emissivity float64, ambient tempconv.Temperature) (tempconv.Kelvin, error) {
+	if err := validateWavelength(wavelength); err != nil {
+		return 0, err
+	}
+	if err := validateEmissivity(emissivity); err != nil {
+		return 0, err
+	}
+	emitted := radiance
+	if ambient != nil {
+		tamb := ambient.ToKelvin()
+		if _, err := tempconv.NewKelvin(float64(tamb)); err != nil {
+			return 0, err
+		}
+		emitted -= (1 - emissivity) * planck(float64(tamb), wavelength)
+	}
+	if !(emitted > 0) || math.IsInf(emitted, 1) {
+		return 0, fmt.Errorf("%w: собственное излучение объекта %v Вт/(м²·ср·м)", ErrInvalidRadiance, emitted)
+	}
+	return tempconv.Kelvin(inversePlanck(emitted/emissivity, wavelength)), nil
+}
+
+// planck вычисляет спектральную яркость по закону Планка.
+func planck(tk, wavelength float64) float64 {
+	if tk == 0 {
+		return 0
+	}
+	return firstRadiation / math.Pow(wavelength, 5) / math.Expm1(secondRadiation/(wavelength*tk))
+}
+
+// inversePlanck обращает закон Планка и возвращает температуру в кельвинах.
+func inversePlanck(radiance, wavelength float64) float64 {
+	return secondRadiation / (wavelength * math.Log1p(firstRadiation/(math.Pow(wavelength, 5)*radiance)))
+}
+
+// validateWavelength проверяет, что длина волны положительна и конечна.
+func validateWavelength(wavelength float64) error {
+	if !(wavelength > 0) || math.IsInf(wavelength, 1) {
+		return fmt.Errorf("%w: %v м", ErrInvalidWavelength, wavelength)
+	}
+	return nil
+}
+
+// validateEmissivity проверяет, что излучательная способность лежит в (0, 1].
+func validateEmissivity(emissivity float64) error {
+	if !(emissivity > 0 && emissivity <= 1) {
+		return fmt.Errorf("%w: %v", ErrInvalidEmissivity, emissivity)
+	}
+	return nil
+}
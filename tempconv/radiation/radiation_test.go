@@ -0,0 +1,134 @@
+package radiation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// almostEqual проверяет, что два числа почти равны с заданной погрешностью.
+func almostEqual(a, b float64, epsilon float64) bool {
+	return math.Abs(a-b) <= epsilon
+}
+
+// TestPeakWavelength проверяет закон смещения Вина.
+func TestPeakWavelength(t *testing.T) {
+	tests := []struct {
+		kelvin   tempconv.Kelvin
+		expected float64
+	}{
+		{5778, 501.52e-9}, // Солнце
+		{310, 9.3477e-6},  // тело человека
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("Wien %v", tt.kelvin), func(t *testing.T) {
+			got, err := PeakWavelength(tt.kelvin)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !almostEqual(got, tt.expected, tt.expected*1e-4) {
+				t.Errorf("PeakWavelength() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestExitance проверяет закон Стефана-Больцмана и его обращение.
+func TestExitance(t *testing.T) {
+	m, err := Exitance(tempconv.Celsius(100).ToKelvin(), 0.9)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !almostEqual(m, 989.44, 0.01) {
+		t.Errorf("Exitance() = %v, want 989.44", m)
+	}
+	k, err := TemperatureFromExitance(m, 0.9)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := float64(k.ToCelsius()); !almostEqual(got, 100, 1e-9) {
+		t.Errorf("TemperatureFromExitance() = %v, want 100°C", got)
+	}
+}
+
+// TestSpectralRadiance проверяет закон Планка и яркостную температуру.
+func TestSpectralRadiance(t *testing.T) {
+	l, err := SpectralRadiance(5778, 500e-9)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !almostEqual(l, 2.6376e13, 1e9) {
+		t.Errorf("SpectralRadiance() = %v, want 2.6376e13", l)
+	}
+	k, err := BrightnessTemperature(l, 500e-9)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !almostEqual(float64(k), 5778, 1e-6) {
+		t.Errorf("BrightnessTemperature() = %v, want 5778K", k)
+	}
+	if l, _ := SpectralRadiance(0, 10e-6); l != 0 {
+		t.Errorf("SpectralRadiance(0K) = %v, want 0", l)
+	}
+}
+
+// TestRadianceTemperature проверяет поправку на излучательную способность и
+// отраженное излучение окружения.
+func TestRadianceTemperature(t *testing.T) {
+	const (
+		wavelength = 10e-6
+		emissivity = 0.8
+	)
+	object := tempconv.Celsius(60).ToKelvin()
+	ambient := tempconv.Fahrenheit(68)
+
+	bObject, _ := SpectralRadiance(object, wavelength)
+	bAmbient, _ := SpectralRadiance(ambient.ToKelvin(), wavelength)
+	measured := emissivity*bObject + (1-emissivity)*bAmbient
+
+	k, err := RadianceTemperature(measured, wavelength, emissivity, ambient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := float64(k.ToCelsius()); !almostEqual(got, 60, 1e-6) {
+		t.Errorf("RadianceTemperature() = %v, want 60°C", got)
+	}
+
+	// Без учета окружения объект с ε < 1 кажется холоднее истинной температуры
+	brightness, _ := BrightnessTemperature(measured, wavelength)
+	if brightness >= object {
+		t.Errorf("BrightnessTemperature() = %v, must be below %v", brightness, object)
+	}
+}
+
+// TestErrors проверяет обработку некорректных входных данных.
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"wavelength", second(SpectralRadiance(300, 0)), ErrInvalidWavelength},
+		{"kelvin", second(SpectralRadiance(-1, 1e-6)), tempconv.ErrBelowAbsoluteZero},
+		{"peak", second(PeakWavelength(0)), tempconv.ErrBelowAbsoluteZero},
+		{"emissivity", second(Exitance(300, 1.5)), ErrInvalidEmissivity},
+		{"exitance", second(TemperatureFromExitance(-1, 1)), ErrInvalidRadiance},
+		{"radiance", second(BrightnessTemperature(0, 1e-6)), ErrInvalidRadiance},
+		{"ambient", second(RadianceTemperature(1, 10e-6, 0.5, tempconv.Celsius(1000))), ErrInvalidRadiance},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !errors.Is(tt.err, tt.want) {
+				t.Errorf("expected error %v, got %v", tt.want, tt.err)
+			}
+		})
+	}
+}
+
+// second возвращает ошибку из пары (значение, ошибка).
+func second[T any](_ T, err error) error { return err }
@@ -0,0 +1,255 @@
+package tempconv
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Scale - идентификатор температурной шкалы. Нулевое значение не соответствует
+// ни одной шкале и считается недопустимым.
+type Scale int
+
+// Поддерживаемые температурные шкалы
+const (
+	// ScaleCelsius - шкала Цельсия
+	ScaleCelsius Scale = iota + 1
+	// ScaleFahrenheit - шкала Фаренгейта
+	ScaleFahrenheit
+	// ScaleKelvin - шкала Кельвина
+	ScaleKelvin
+	// ScaleRankine - шкала Ранкина
+	ScaleRankine
+	// ScaleReaumur - шкала Реомюра
+	ScaleReaumur
+	// ScaleDelisle - шкала Делисля
+	ScaleDelisle
+	// ScaleNewton - шкала Ньютона
+	ScaleNewton
+)
+
+// Scales возвращает список всех поддерживаемых шкал.
+func Scales() []Scale {
+	return []Scale{
+		ScaleCelsius, ScaleFahrenheit, ScaleKelvin, ScaleRankine,
+		ScaleReaumur, ScaleDelisle, ScaleNewton,
+	}
+}
+
+// Valid сообщает, соответствует ли значение одной из поддерживаемых шкал.
+func (s Scale) Valid() bool { return s >= ScaleCelsius && s <= ScaleNewton }
+
+// String возвращает название шкалы в том же виде, что и ScaleName у
+// соответствующего типа температуры.
+func (s Scale) String() string {
+	switch s {
+	case ScaleCelsius:
+		return "Celsius"
+	case ScaleFahrenheit:
+		return "Fahrenheit"
+	case ScaleKelvin:
+		return "Kelvin"
+	case ScaleRankine:
+		return "Rankine"
+	case ScaleReaumur:
+		return "Reaumur"
+	case ScaleDelisle:
+		return "Delisle"
+	case ScaleNewton:
+		return "Newton"
+	}
+	return fmt.Sprintf("Scale(%d)", int(s))
+}
+
+// Symbol возвращает обозначение шкалы (°C, °F, K и т.д.).
+func (s Scale) Symbol() string {
+	switch s {
+	case ScaleCelsius:
+		return "°C"
+	case ScaleFahrenheit:
+		return "°F"
+	case ScaleKelvin:
+		return "K"
+	case ScaleRankine:
+		return "°R"
+	case ScaleReaumur:
+		return "°Re"
+	case ScaleDelisle:
+		return "°De"
+	case ScaleNewton:
+		return "°N"
+	}
+	return ""
+}
+
+// AbsoluteZero возвращает абсолютный ноль в данной шкале или nil для
+// недопустимой шкалы.
+func (s Scale) AbsoluteZero() Temperature {
+	switch s {
+	case ScaleCelsius:
+		return absoluteZeroC
+	case ScaleFahrenheit:
+		return absoluteZeroF
+	case ScaleKelvin:
+		return absoluteZeroK
+	case ScaleRankine:
+		return absoluteZeroR
+	case ScaleReaumur:
+		return absoluteZeroRe
+	case ScaleDelisle:
+		return absoluteZeroDe
+	case ScaleNewton:
+		return absoluteZeroN
+	}
+	return nil
+}
+
+// New создает температуру в данной шкале через соответствующий конструктор
+// (NewCelsius, NewKelvin и т.д.), поэтому значения ниже абсолютного нуля
+// отклоняются с ошибкой ErrBelowAbsoluteZero.
+func (s Scale) New(v float64) (Temperature, error) {
+	switch s {
+	case ScaleCelsius:
+		return NewCelsius(v)
+	case ScaleFahrenheit:
+		return NewFahrenheit(v)
+	case ScaleKelvin:
+		return NewKelvin(v)
+	case ScaleRankine:
+		return NewRankine(v)
+	case ScaleReaumur:
+		return NewReaumur(v)
+	case ScaleDelisle:
+		return NewDelisle(v)
+	case ScaleNewton:
+		return NewNewton(v)
+	}
+	return nil, fmt.Errorf("%w: %v", ErrUnknownScale, s)
+}
+
+// Of возвращает значение v как температуру в данной шкале без проверки
+// абсолютного нуля. Для недопустимой шкалы возвращается nil.
+func (s Scale) Of(v float64) Temperature {
+	switch s {
+	case ScaleCelsius:
+		return Celsius(v)
+	case ScaleFahrenheit:
+		return Fahrenheit(v)
+	case ScaleKelvin:
+		return Kelvin(v)
+	case ScaleRankine:
+		return Rankine(v)
+	case ScaleReaumur:
+		return Reaumur(v)
+	case ScaleDelisle:
+		return Delisle(v)
+	case ScaleNewton:
+		return Newton(v)
+	}
+	return nil
+}
+
+// Convert преобразует температуру t в данную шкалу. Для недопустимой шкалы
+// возвращается nil.
+func (s Scale) Convert(t Temperature) Temperature {
+	switch s {
+	case ScaleCelsius:
+		return t.ToCelsius()
+	case ScaleFahrenheit:
+		return t.ToFahrenheit()
+	case ScaleKelvin:
+		return t.ToKelvin()
+	case ScaleRankine:
+		return t.ToRankine()
+	case ScaleReaumur:
+		return t.ToReaumur()
+	case ScaleDelisle:
+		return t.ToDelisle()
+	case ScaleNewton:
+		return t.ToNewton()
+	}
+	return nil
+}
+
+// ScaleOf возвращает шкалу, в которой задана температура t. Для собственных
+// реализаций интерфейса Temperature шкала определяется по ScaleName. Если
+// шкала неизвестна, возвращается нулевое значение Scale.
+func ScaleOf(t Temperature) Scale {
+	switch t.(type) {
+	case Celsius:
+		return ScaleCelsius
+	case Fahrenheit:
+		return ScaleFahrenheit
+	case Kelvin:
+		return ScaleKelvin
+	case Rankine:
+		return ScaleRankine
+	case Reaumur:
+		return ScaleReaumur
+	case Delisle:
+		return ScaleDelisle
+	case Newton:
+		return ScaleNewton
+	case nil:
+		return 0
+	}
+	for _, s := range Scales() {
+		if s.String() == t.ScaleName() {
+			return s
+		}
+	}
+	return 0
+}
+
+// ValueOf возвращает числовое значение температуры в ее собственной шкале.
+// Если шкала неизвестна, возвращается NaN.
+func ValueOf(t Temperature) float64 {
+	switch v := t.(type) {
+	case Celsius:
+		return float64(v)
+	case Fahrenheit:
+		return float64(v)
+	case Kelvin:
+		return float64(v)
+	case Rankine:
+		return float64(v)
+	case Reaumur:
+		return float64(v)
+	case Delisle:
+		return float64(v)
+	case Newton:
+		return float64(v)
+	case nil:
+		return math.NaN()
+	}
+	if s := ScaleOf(t); s.Valid() {
+		return ValueOf(s.Convert(t))
+	}
+	return math.NaN()
+}
+
+// ParseScale разбирает обозначение шкалы. Допускаются названия шкал
+// ("Celsius", "kelvin"), обозначения со знаком градуса и без него ("°F", "F",
+// "Re", "De") без учета регистра. Обозначение "R" соответствует шкале Ранкина.
+func ParseScale(s string) (Scale, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	name = strings.TrimPrefix(name, "°")
+	name = strings.TrimPrefix(name, "º")
+	switch name {
+	case "c", "celsius":
+		return ScaleCelsius, nil
+	case "f", "fahrenheit":
+		return ScaleFahrenheit, nil
+	case "k", "kelvin":
+		return ScaleKelvin, nil
+	case "r", "ra", "rankine":
+		return ScaleRankine, nil
+	case "re", "ré", "reaumur", "réaumur":
+		return ScaleReaumur, nil
+	case "de", "delisle":
+		return ScaleDelisle, nil
+	case "n", "newton":
+		return ScaleNewton, nil
+	}
+	return 0, fmt.Errorf("%w: %q", ErrUnknownScale, s)
+}
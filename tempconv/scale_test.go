@@ -0,0 +1,96 @@
+package tempconv
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// TestParseScale проверяет разбор названий и обозначений шкал.
+func TestParseScale(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Scale
+		err      error
+	}{
+		{"C", ScaleCelsius, nil},
+		{"°C", ScaleCelsius, nil},
+		{"celsius", ScaleCelsius, nil},
+		{" °F ", ScaleFahrenheit, nil},
+		{"K", ScaleKelvin, nil},
+		{"°R", ScaleRankine, nil},
+		{"Re", ScaleReaumur, nil},
+		{"°De", ScaleDelisle, nil},
+		{"Newton", ScaleNewton, nil},
+		{"X", 0, ErrUnknownScale},
+		{"", 0, ErrUnknownScale},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("ParseScale %q", tt.input), func(t *testing.T) {
+			s, err := ParseScale(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if s != tt.expected {
+				t.Fatalf("expected %v, got %v", tt.expected, s)
+			}
+		})
+	}
+}
+
+// TestScaleRoundTrip проверяет согласованность Scale с типами температур:
+// название, обозначение, конструктор и преобразование.
+func TestScaleRoundTrip(t *testing.T) {
+	for _, s := range Scales() {
+		t.Run(s.String(), func(t *testing.T) {
+			zero := s.AbsoluteZero()
+			if got := ScaleOf(zero); got != s {
+				t.Fatalf("ScaleOf(%v) = %v, want %v", zero, got, s)
+			}
+			if zero.ScaleName() != s.String() {
+				t.Errorf("ScaleName() = %v, want %v", zero.ScaleName(), s.String())
+			}
+			parsed, err := ParseScale(s.Symbol())
+			if err != nil || parsed != s {
+				t.Errorf("ParseScale(%q) = %v, %v", s.Symbol(), parsed, err)
+			}
+
+			boiling := s.Convert(Celsius(100))
+			if ScaleOf(boiling) != s {
+				t.Fatalf("Convert returned %v, want scale %v", boiling.ScaleName(), s)
+			}
+			if got := s.Of(ValueOf(boiling)); got != boiling {
+				t.Errorf("Of(%v) = %v, want %v", ValueOf(boiling), got, boiling)
+			}
+			created, err := s.New(ValueOf(boiling))
+			if err != nil {
+				t.Fatalf("New(%v) error: %v", ValueOf(boiling), err)
+			}
+			if got := float64(created.ToCelsius()); !almostEqual(got, 100, 1e-9) {
+				t.Errorf("round trip = %v, want 100", got)
+			}
+		})
+	}
+}
+
+// TestScaleInvalid проверяет поведение нулевой шкалы.
+func TestScaleInvalid(t *testing.T) {
+	var s Scale
+	if s.Valid() {
+		t.Fatal("zero Scale must be invalid")
+	}
+	if _, err := s.New(0); !errors.Is(err, ErrUnknownScale) {
+		t.Errorf("expected error %v, got %v", ErrUnknownScale, err)
+	}
+	if s.Convert(Celsius(0)) != nil || s.AbsoluteZero() != nil || s.Of(0) != nil {
+		t.Error("invalid scale must return nil temperatures")
+	}
+	if _, err := ScaleKelvin.New(-1); !errors.Is(err, ErrBelowAbsoluteZero) {
+		t.Errorf("expected error %v, got %v", ErrBelowAbsoluteZero, err)
+	}
+	if !math.IsNaN(ValueOf(nil)) {
+		t.Error("ValueOf(nil) must be NaN")
+	}
+}
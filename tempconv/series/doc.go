@@ -0,0 +1,21 @@
+// Пакет series содержит тип Series - временной ряд показаний температуры в
+// фиксированной шкале.
+//
+// Ряд поддерживает добавление показаний в любой шкале (с преобразованием в
+// шкалу ряда и проверкой абсолютного нуля), сортировку, выборку по интервалу
+// времени, передискретизацию с агрегированием (среднее, минимум, максимум,
+// последнее значение), поиск пропусков, линейную интерполяцию и
+// преобразование всего ряда в другую шкалу.
+//
+// # Пример использования:
+//
+//	s, _ := series.New(tempconv.ScaleCelsius)
+//	_ = s.Append(t0, tempconv.Celsius(20))
+//	_ = s.Append(t0.Add(30*time.Second), tempconv.Fahrenheit(70))
+//	hourly, err := s.Resample(time.Hour, series.Mean)
+//	if err != nil {
+//	    fmt.Println("Ошибка:", err)
+//	    return
+//	}
+//	fmt.Println(hourly.Temperature(0)) // 20.56°C
+package series
@@ -0,0 +1,256 @@
+package series
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// Ошибки операций над временными рядами
+var (
+	ErrEmptySeries        = errors.New("временной ряд пуст")
+	ErrOutOfRange         = errors.New("момент времени вне интервала ряда")
+	ErrInvalidInterval    = errors.New("недопустимый интервал")
+	ErrUnknownAggregation = errors.New("неизвестная функция агрегирования")
+	ErrNilTemperature     = errors.New("не задана температура")
+)
+
+// Reading - показание температуры в шкале ряда.
+type Reading struct {
+	// Time - момент измерения
+	Time time.Time
+	// Value - значение температуры в шкале ряда
+	Value float64
+}
+
+// Gap - пропуск в данных между двумя соседними показаниями.
+type Gap struct {
+	// Start - момент последнего показания перед пропуском
+	Start time.Time
+	// End - момент первого показания после пропуска
+	End time.Time
+}
+
+// Duration возвращает длительность пропуска.
+func (g Gap) Duration() time.Duration { return g.End.Sub(g.Start) }
+
+// Aggregation - функция агрегирования показаний внутри интервала
+// передискретизации.
+type Aggregation int
+
+// Поддерживаемые функции агрегирования
+const (
+	// Mean - среднее значение
+	Mean Aggregation = iota
+	// Min - самое низкое значение температуры
+	Min
+	// Max - самое высокое значение температуры
+	Max
+	// Last - последнее по времени значение
+	Last
+)
+
+// String возвращает название функции агрегирования.
+func (a Aggregation) String() string {
+	switch a {
+	case Mean:
+		return "mean"
+	case Min:
+		return "min"
+	case Max:
+		return "max"
+	case Last:
+		return "last"
+	}
+	return fmt.Sprintf("Aggregation(%d)", int(a))
+}
+
+// Series - временной ряд показаний температуры в фиксированной шкале.
+// Нулевое значение непригодно к использованию, ряд создается функцией New.
+type Series struct {
+	scale    tempconv.Scale
+	readings []Reading
+	sorted   bool
+}
+
+// New создает пустой временной ряд в шкале scale.
+func New(scale tempconv.Scale) (*Series, error) {
+	if !scale.Valid() {
+		return nil, fmt.Errorf("%w: %v", tempconv.ErrUnknownScale, scale)
+	}
+	return &Series{scale: scale, sorted: true}, nil
+}
+
+// Scale возвращает шкалу ряда.
+func (s *Series) Scale() tempconv.Scale { return s.scale }
+
+// Len возвращает количество показаний в ряду.
+func (s *Series) Len() int { return len(s.readings) }
+
+// Readings возвращает копию показаний ряда в текущем порядке.
+func (s *Series) Readings() []Reading { return slices.Clone(s.readings) }
+
+// At возвращает i-е показание ряда.
+func (s *Series) At(i int) Reading { return s.readings[i] }
+
+// Temperature возвращает значение i-го показания как температуру в шкале ряда.
+func (s *Series) Temperature(i int) tempconv.Temperature {
+	return s.temperature(s.readings[i].Value)
+}
+
+// Append добавляет показание температуры temp в момент t. Температура
+// преобразуется в шкалу ряда; значения ниже абсолютного нуля отклоняются.
+func (s *Series) Append(t time.Time, temp tempconv.Temperature) error {
+	if temp == nil {
+		return ErrNilTemperature
+	}
+	v, err := s.scale.New(tempconv.ValueOf(s.scale.Convert(temp)))
+	if err != nil {
+		return err
+	}
+	if n := len(s.readings); n > 0 && t.Before(s.readings[n-1].Time) {
+		s.sorted = false
+	}
+	s.readings = append(s.readings, Reading{Time: t, Value: tempconv.ValueOf(v)})
+	return nil
+}
+
+// Sort упорядочивает показания по времени. Порядок показаний с одинаковым
+// временем сохраняется.
+func (s *Series) Sort() {
+	if s.sorted {
+		return
+	}
+	slices.SortStableFunc(s.readings, func(a, b Reading) int { return a.Time.Compare(b.Time) })
+	s.sorted = true
+}
+
+// Range возвращает новый ряд с показаниями из полуинтервала [from, to).
+func (s *Series) Range(from, to time.Time) *Series {
+	out := &Series{scale: s.scale, sorted: s.sorted}
+	for _, r := range s.readings {
+		if !r.Time.Before(from) && r.Time.Before(to) {
+			out.readings = append(out.readings, r)
+		}
+	}
+	return out
+}
+
+// Resample группирует показания в интервалы длительностью interval,
+// выровненные по time.Time.Truncate, и агрегирует каждый интервал функцией
+// agg. Метка времени результата - начало интервала; пустые интервалы
+// пропускаются.
+func (s *Series) Resample(interval time.Duration, agg Aggregation) (*Series, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInterval, interval)
+	}
+	if agg < Mean || agg > Last {
+		return nil, fmt.Errorf("%w: %v", ErrUnknownAggregation, agg)
+	}
+
+	out := &Series{scale: s.scale, sorted: true}
+	readings := s.ordered()
+	for start := 0; start < len(readings); {
+		bucket := readings[start].Time.Truncate(interval)
+		end := start + 1
+		for end < len(readings) && readings[end].Time.Truncate(interval).Equal(bucket) {
+			end++
+		}
+		out.readings = append(out.readings, Reading{Time: bucket, Value: s.aggregate(readings[start:end], agg)})
+		start = end
+	}
+	return out, nil
+}
+
+// Gaps возвращает пропуски - пары соседних по времени показаний, интервал
+// между которыми больше maxInterval.
+func (s *Series) Gaps(maxInterval time.Duration) ([]Gap, error) {
+	if maxInterval <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInterval, maxInterval)
+	}
+	var gaps []Gap
+	readings := s.ordered()
+	for i := 1; i < len(readings); i++ {
+		if readings[i].Time.Sub(readings[i-1].Time) > maxInterval {
+			gaps = append(gaps, Gap{Start: readings[i-1].Time, End: readings[i].Time})
+		}
+	}
+	return gaps, nil
+}
+
+// Interpolate возвращает температуру в момент t, линейно интерполированную
+// между соседними показаниями. Момент t должен лежать между первым и
+// последним показанием ряда.
+func (s *Series) Interpolate(t time.Time) (tempconv.Temperature, error) {
+	readings := s.ordered()
+	if len(readings) == 0 {
+		return nil, ErrEmptySeries
+	}
+	first, last := readings[0].Time, readings[len(readings)-1].Time
+	if t.Before(first) || t.After(last) {
+		return nil, fmt.Errorf("%w: %v не в [%v, %v]", ErrOutOfRange, t, first, last)
+	}
+
+	i, _ := slices.BinarySearchFunc(readings, t, func(r Reading, t time.Time) int { return r.Time.Compare(t) })
+	if readings[i].Time.Equal(t) {
+		return s.temperature(readings[i].Value), nil
+	}
+	a, b := readings[i-1], readings[i]
+	frac := float64(t.Sub(a.Time)) / float64(b.Time.Sub(a.Time))
+	return s.temperature(a.Value + frac*(b.Value-a.Value)), nil
+}
+
+// Convert возвращает копию ряда, преобразованную в шкалу scale.
+func (s *Series) Convert(scale tempconv.Scale) (*Series, error) {
+	if !scale.Valid() {
+		return nil, fmt.Errorf("%w: %v", tempconv.ErrUnknownScale, scale)
+	}
+	out := &Series{scale: scale, sorted: s.sorted, readings: make([]Reading, len(s.readings))}
+	for i, r := range s.readings {
+		out.readings[i] = Reading{Time: r.Time, Value: tempconv.ValueOf(scale.Convert(s.temperature(r.Value)))}
+	}
+	return out, nil
+}
+
+// ordered возвращает показания, упорядоченные по времени, не изменяя ряд.
+func (s *Series) ordered() []Reading {
+	if s.sorted {
+		return s.readings
+	}
+	readings := slices.Clone(s.readings)
+	slices.SortStableFunc(readings, func(a, b Reading) int { return a.Time.Compare(b.Time) })
+	return readings
+}
+
+// aggregate вычисляет агрегат непустого набора показаний, упорядоченных по
+// времени.
+func (s *Series) aggregate(readings []Reading, agg Aggregation) float64 {
+	switch agg {
+	case Min, Max:
+		best := readings[0]
+		for _, r := range readings[1:] {
+			// Сравнение выполняется по температуре, а не по числу: в шкале
+			// Делисля большее значение соответствует более холодному телу.
+			hotter := s.temperature(r.Value).ToKelvin() > s.temperature(best.Value).ToKelvin()
+			if hotter == (agg == Max) {
+				best = r
+			}
+		}
+		return best.Value
+	case Last:
+		return readings[len(readings)-1].Value
+	}
+	var sum float64
+	for _, r := range readings {
+		sum += r.Value
+	}
+	return sum / float64(len(readings))
+}
+
+// temperature возвращает значение v как температуру в шкале ряда.
+func (s *Series) temperature(v float64) tempconv.Temperature {
+	return s.scale.Of(v)
+}
@@ -0,0 +1,204 @@
+package series
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// almostEqual проверяет, что два числа почти равны с заданной погрешностью.
+func almostEqual(a, b float64, epsilon float64) bool {
+	return math.Abs(a-b) <= epsilon
+}
+
+// t0 - начало тестовых рядов
+var t0 = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// newSeries создает ряд в шкале scale из значений в градусах Цельсия с
+// заданными смещениями от t0.
+func newSeries(t *testing.T, scale tempconv.Scale, offsets []time.Duration, values []float64) *Series {
+	t.Helper()
+	s, err := New(scale)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, off := range offsets {
+		if err := s.Append(t0.Add(off), tempconv.Celsius(values[i])); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	return s
+}
+
+// TestAppend проверяет преобразование показаний в шкалу ряда и проверку
+// абсолютного нуля.
+func TestAppend(t *testing.T) {
+	s, _ := New(tempconv.ScaleCelsius)
+	if err := s.Append(t0, tempconv.Fahrenheit(212)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := s.At(0).Value; !almostEqual(got, 100, 1e-9) {
+		t.Errorf("Value = %v, want 100", got)
+	}
+	if _, ok := s.Temperature(0).(tempconv.Celsius); !ok {
+		t.Errorf("Temperature() has type %T, want tempconv.Celsius", s.Temperature(0))
+	}
+	if err := s.Append(t0, tempconv.Kelvin(-1)); !errors.Is(err, tempconv.ErrBelowAbsoluteZero) {
+		t.Errorf("expected error %v, got %v", tempconv.ErrBelowAbsoluteZero, err)
+	}
+	if err := s.Append(t0, nil); !errors.Is(err, ErrNilTemperature) {
+		t.Errorf("expected error %v, got %v", ErrNilTemperature, err)
+	}
+	if _, err := New(0); !errors.Is(err, tempconv.ErrUnknownScale) {
+		t.Errorf("expected error %v, got %v", tempconv.ErrUnknownScale, err)
+	}
+}
+
+// TestSortAndRange проверяет сортировку и выборку по интервалу.
+func TestSortAndRange(t *testing.T) {
+	s := newSeries(t, tempconv.ScaleCelsius,
+		[]time.Duration{2 * time.Minute, 0, time.Minute, 3 * time.Minute},
+		[]float64{12, 10, 11, 13})
+	s.Sort()
+	for i, want := range []float64{10, 11, 12, 13} {
+		if got := s.At(i).Value; got != want {
+			t.Errorf("At(%d) = %v, want %v", i, got, want)
+		}
+	}
+
+	r := s.Range(t0.Add(time.Minute), t0.Add(3*time.Minute))
+	if r.Len() != 2 || r.At(0).Value != 11 || r.At(1).Value != 12 {
+		t.Errorf("Range() = %v, want values [11 12]", r.Readings())
+	}
+}
+
+// TestResample проверяет передискретизацию с различными агрегатами.
+func TestResample(t *testing.T) {
+	s := newSeries(t, tempconv.ScaleCelsius,
+		[]time.Duration{0, 20 * time.Minute, 40 * time.Minute, 70 * time.Minute, 3 * time.Hour},
+		[]float64{10, 14, 12, 20, 5})
+
+	tests := []struct {
+		agg      Aggregation
+		expected []float64
+	}{
+		{Mean, []float64{12, 20, 5}},
+		{Min, []float64{10, 20, 5}},
+		{Max, []float64{14, 20, 5}},
+		{Last, []float64{12, 20, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.agg.String(), func(t *testing.T) {
+			r, err := s.Resample(time.Hour, tt.agg)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if r.Len() != len(tt.expected) {
+				t.Fatalf("Len() = %d, want %d", r.Len(), len(tt.expected))
+			}
+			for i, want := range tt.expected {
+				if got := r.At(i).Value; !almostEqual(got, want, 1e-9) {
+					t.Errorf("bucket %d = %v, want %v", i, got, want)
+				}
+			}
+			if !r.At(2).Time.Equal(t0.Add(3 * time.Hour)) {
+				t.Errorf("bucket time = %v, want %v", r.At(2).Time, t0.Add(3*time.Hour))
+			}
+		})
+	}
+
+	if _, err := s.Resample(0, Mean); !errors.Is(err, ErrInvalidInterval) {
+		t.Errorf("expected error %v, got %v", ErrInvalidInterval, err)
+	}
+	if _, err := s.Resample(time.Hour, Aggregation(9)); !errors.Is(err, ErrUnknownAggregation) {
+		t.Errorf("expected error %v, got %v", ErrUnknownAggregation, err)
+	}
+}
+
+// TestResampleDelisle проверяет, что минимум и максимум выбираются по
+// температуре, а не по числовому значению в обратной шкале Делисля.
+func TestResampleDelisle(t *testing.T) {
+	s := newSeries(t, tempconv.ScaleDelisle, []time.Duration{0, time.Minute}, []float64{10, 30})
+
+	hot, _ := s.Resample(time.Hour, Max)
+	if got := float64(hot.Temperature(0).ToCelsius()); !almostEqual(got, 30, 1e-9) {
+		t.Errorf("Max = %v°C, want 30°C", got)
+	}
+	cold, _ := s.Resample(time.Hour, Min)
+	if got := float64(cold.Temperature(0).ToCelsius()); !almostEqual(got, 10, 1e-9) {
+		t.Errorf("Min = %v°C, want 10°C", got)
+	}
+}
+
+// TestGaps проверяет поиск пропусков в неупорядоченном ряду.
+func TestGaps(t *testing.T) {
+	s := newSeries(t, tempconv.ScaleCelsius,
+		[]time.Duration{0, 10 * time.Minute, time.Minute, 2 * time.Minute},
+		[]float64{1, 2, 3, 4})
+	gaps, err := s.Gaps(5 * time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(gaps) != 1 || gaps[0].Duration() != 8*time.Minute {
+		t.Errorf("Gaps() = %v, want one gap of 8m", gaps)
+	}
+}
+
+// TestInterpolate проверяет линейную интерполяцию.
+func TestInterpolate(t *testing.T) {
+	s := newSeries(t, tempconv.ScaleKelvin,
+		[]time.Duration{0, 10 * time.Minute, 20 * time.Minute},
+		[]float64{0, 10, 30})
+
+	tests := []struct {
+		at       time.Duration
+		expected float64
+	}{
+		{0, 0},
+		{5 * time.Minute, 5},
+		{10 * time.Minute, 10},
+		{15 * time.Minute, 20},
+	}
+
+	for _, tt := range tests {
+		got, err := s.Interpolate(t0.Add(tt.at))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if c := float64(got.ToCelsius()); !almostEqual(c, tt.expected, 1e-9) {
+			t.Errorf("Interpolate(%v) = %v°C, want %v°C", tt.at, c, tt.expected)
+		}
+	}
+
+	if _, err := s.Interpolate(t0.Add(time.Hour)); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("expected error %v, got %v", ErrOutOfRange, err)
+	}
+	empty, _ := New(tempconv.ScaleKelvin)
+	if _, err := empty.Interpolate(t0); !errors.Is(err, ErrEmptySeries) {
+		t.Errorf("expected error %v, got %v", ErrEmptySeries, err)
+	}
+}
+
+// TestConvert проверяет преобразование всего ряда в другую шкалу.
+func TestConvert(t *testing.T) {
+	s := newSeries(t, tempconv.ScaleCelsius, []time.Duration{0, time.Minute}, []float64{0, 100})
+	f, err := s.Convert(tempconv.ScaleFahrenheit)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if f.Scale() != tempconv.ScaleFahrenheit {
+		t.Errorf("Scale() = %v, want Fahrenheit", f.Scale())
+	}
+	for i, want := range []float64{32, 212} {
+		if got := f.At(i).Value; !almostEqual(got, want, 1e-9) {
+			t.Errorf("At(%d) = %v, want %v", i, got, want)
+		}
+	}
+	if s.At(1).Value != 100 {
+		t.Error("Convert must not modify the source series")
+	}
+}
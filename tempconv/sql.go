@@ -0,0 +1,336 @@
+package tempconv
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Поддержка database/sql: каждый тип температуры реализует sql.Scanner и
+// driver.Valuer и хранится в базе данных как число с плавающей точкой.
+// Значения проверяются конструкторами New* как при чтении, так и при записи,
+// поэтому температура ниже абсолютного нуля не попадет в базу данных и не
+// будет из нее прочитана. Для столбцов, допускающих NULL, предназначены типы
+// NullCelsius, NullKelvin и т.д.
+
+// Scan читает температуру в шкале Цельсия из значения столбца базы данных.
+func (c *Celsius) Scan(src any) error { return scanInto(c, src, NewCelsius) }
+
+// Value возвращает температуру в шкале Цельсия для записи в базу данных.
+func (c Celsius) Value() (driver.Value, error) { return value(c, NewCelsius) }
+
+// NullCelsius - температура в шкале Цельсия, которая может быть NULL.
+type NullCelsius struct {
+	Celsius Celsius
+	Valid   bool // Valid равно true, если значение не NULL
+}
+
+// Scan читает температуру в шкале Цельсия или NULL из значения столбца.
+func (n *NullCelsius) Scan(src any) error {
+	if src == nil {
+		*n = NullCelsius{}
+		return nil
+	}
+	if err := n.Celsius.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value возвращает температуру в шкале Цельсия или NULL для записи в базу данных.
+func (n NullCelsius) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Celsius.Value()
+}
+
+// Scan читает температуру в шкале Фаренгейта из значения столбца базы данных.
+func (f *Fahrenheit) Scan(src any) error { return scanInto(f, src, NewFahrenheit) }
+
+// Value возвращает температуру в шкале Фаренгейта для записи в базу данных.
+func (f Fahrenheit) Value() (driver.Value, error) { return value(f, NewFahrenheit) }
+
+// NullFahrenheit - температура в шкале Фаренгейта, которая может быть NULL.
+type NullFahrenheit struct {
+	Fahrenheit Fahrenheit
+	Valid      bool // Valid равно true, если значение не NULL
+}
+
+// Scan читает температуру в шкале Фаренгейта или NULL из значения столбца.
+func (n *NullFahrenheit) Scan(src any) error {
+	if src == nil {
+		*n = NullFahrenheit{}
+		return nil
+	}
+	if err := n.Fahrenheit.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value возвращает температуру в шкале Фаренгейта или NULL для записи в базу данных.
+func (n NullFahrenheit) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Fahrenheit.Value()
+}
+
+// Scan читает температуру в шкале Кельвина из значения столбца базы данных.
+func (k *Kelvin) Scan(src any) error { return scanInto(k, src, NewKelvin) }
+
+// Value возвращает температуру в шкале Кельвина для записи в базу данных.
+func (k Kelvin) Value() (driver.Value, error) { return value(k, NewKelvin) }
+
+// NullKelvin - температура в шкале Кельвина, которая может быть NULL.
+type NullKelvin struct {
+	Kelvin Kelvin
+	Valid  bool // Valid равно true, если значение не NULL
+}
+
+// Scan читает температуру в шкале Кельвина или NULL из значения столбца.
+func (n *NullKelvin) Scan(src any) error {
+	if src == nil {
+		*n = NullKelvin{}
+		return nil
+	}
+	if err := n.Kelvin.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value возвращает температуру в шкале Кельвина или NULL для записи в базу данных.
+func (n NullKelvin) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Kelvin.Value()
+}
+
+// Scan читает температуру в шкале Ранкина из значения столбца базы данных.
+func (r *Rankine) Scan(src any) error { return scanInto(r, src, NewRankine) }
+
+// Value возвращает температуру в шкале Ранкина для записи в базу данных.
+func (r Rankine) Value() (driver.Value, error) { return value(r, NewRankine) }
+
+// NullRankine - температура в шкале Ранкина, которая может быть NULL.
+type NullRankine struct {
+	Rankine Rankine
+	Valid   bool // Valid равно true, если значение не NULL
+}
+
+// Scan читает температуру в шкале Ранкина или NULL из значения столбца.
+func (n *NullRankine) Scan(src any) error {
+	if src == nil {
+		*n = NullRankine{}
+		return nil
+	}
+	if err := n.Rankine.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value возвращает температуру в шкале Ранкина или NULL для записи в базу данных.
+func (n NullRankine) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Rankine.Value()
+}
+
+// Scan читает температуру в шкале Реомюра из значения столбца базы данных.
+func (re *Reaumur) Scan(src any) error { return scanInto(re, src, NewReaumur) }
+
+// Value возвращает температуру в шкале Реомюра для записи в базу данных.
+func (re Reaumur) Value() (driver.Value, error) { return value(re, NewReaumur) }
+
+// NullReaumur - температура в шкале Реомюра, которая может быть NULL.
+type NullReaumur struct {
+	Reaumur Reaumur
+	Valid   bool // Valid равно true, если значение не NULL
+}
+
+// Scan читает температуру в шкале Реомюра или NULL из значения столбца.
+func (n *NullReaumur) Scan(src any) error {
+	if src == nil {
+		*n = NullReaumur{}
+		return nil
+	}
+	if err := n.Reaumur.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value возвращает температуру в шкале Реомюра или NULL для записи в базу данных.
+func (n NullReaumur) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Reaumur.Value()
+}
+
+// Scan читает температуру в шкале Делисля из значения столбца базы данных.
+func (de *Delisle) Scan(src any) error { return scanInto(de, src, NewDelisle) }
+
+// Value возвращает температуру в шкале Делисля для записи в базу данных.
+func (de Delisle) Value() (driver.Value, error) { return value(de, NewDelisle) }
+
+// NullDelisle - температура в шкале Делисля, которая может быть NULL.
+type NullDelisle struct {
+	Delisle Delisle
+	Valid   bool // Valid равно true, если значение не NULL
+}
+
+// Scan читает температуру в шкале Делисля или NULL из значения столбца.
+func (n *NullDelisle) Scan(src any) error {
+	if src == nil {
+		*n = NullDelisle{}
+		return nil
+	}
+	if err := n.Delisle.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value возвращает температуру в шкале Делисля или NULL для записи в базу данных.
+func (n NullDelisle) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Delisle.Value()
+}
+
+// Scan читает температуру в шкале Ньютона из значения столбца базы данных.
+func (n *Newton) Scan(src any) error { return scanInto(n, src, NewNewton) }
+
+// Value возвращает температуру в шкале Ньютона для записи в базу данных.
+func (n Newton) Value() (driver.Value, error) { return value(n, NewNewton) }
+
+// NullNewton - температура в шкале Ньютона, которая может быть NULL.
+type NullNewton struct {
+	Newton Newton
+	Valid  bool // Valid равно true, если значение не NULL
+}
+
+// Scan читает температуру в шкале Ньютона или NULL из значения столбца.
+func (n *NullNewton) Scan(src any) error {
+	if src == nil {
+		*n = NullNewton{}
+		return nil
+	}
+	if err := n.Newton.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value возвращает температуру в шкале Ньютона или NULL для записи в базу данных.
+func (n NullNewton) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Newton.Value()
+}
+
+// Scan читает шкалу из текстового значения столбца, например "Celsius" или
+// "°F".
+func (s *Scale) Scan(src any) error {
+	var name string
+	switch v := src.(type) {
+	case string:
+		name = v
+	case []byte:
+		name = string(v)
+	default:
+		return fmt.Errorf("%w: тип %T", ErrInvalidSQLValue, src)
+	}
+	scale, err := ParseScale(name)
+	if err != nil {
+		return err
+	}
+	*s = scale
+	return nil
+}
+
+// Value возвращает название шкалы (совпадающее с ScaleName) для записи в базу
+// данных.
+func (s Scale) Value() (driver.Value, error) {
+	if !s.Valid() {
+		return nil, fmt.Errorf("%w: %v", ErrUnknownScale, s)
+	}
+	return s.String(), nil
+}
+
+// scanInto разбирает значение столбца src и сохраняет его в dst после проверки
+// конструктором ctor.
+func scanInto[T ~float64](dst *T, src any, ctor func(float64) (T, error)) error {
+	v, err := scanFloat(src)
+	if err != nil {
+		return err
+	}
+	if math.IsNaN(v) {
+		return fmt.Errorf("%w: NaN", ErrInvalidSQLValue)
+	}
+	t, err := ctor(v)
+	if err != nil {
+		return err
+	}
+	*dst = t
+	return nil
+}
+
+// value проверяет температуру t конструктором ctor и возвращает ее числовое
+// значение для записи в базу данных.
+func value[T ~float64](t T, ctor func(float64) (T, error)) (driver.Value, error) {
+	if math.IsNaN(float64(t)) {
+		return nil, fmt.Errorf("%w: NaN", ErrInvalidSQLValue)
+	}
+	if _, err := ctor(float64(t)); err != nil {
+		return nil, err
+	}
+	return float64(t), nil
+}
+
+// scanFloat преобразует значение столбца в число. Драйверы возвращают числа
+// как float64 или int64, а некоторые (например, для типа NUMERIC) - как
+// строку или []byte.
+func scanFloat(src any) (float64, error) {
+	switch v := src.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case []byte:
+		return parseSQLFloat(string(v))
+	case string:
+		return parseSQLFloat(v)
+	case nil:
+		return 0, fmt.Errorf("%w: NULL", ErrInvalidSQLValue)
+	}
+	return 0, fmt.Errorf("%w: тип %T", ErrInvalidSQLValue, src)
+}
+
+// parseSQLFloat разбирает текстовое представление числа из базы данных.
+func parseSQLFloat(s string) (float64, error) {
+	v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidSQLValue, s)
+	}
+	return v, nil
+}
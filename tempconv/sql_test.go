@@ -0,0 +1,162 @@
+package tempconv
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// Проверка реализации интерфейсов database/sql
+var (
+	_ sql.Scanner   = (*Celsius)(nil)
+	_ sql.Scanner   = (*Fahrenheit)(nil)
+	_ sql.Scanner   = (*Kelvin)(nil)
+	_ sql.Scanner   = (*Rankine)(nil)
+	_ sql.Scanner   = (*Reaumur)(nil)
+	_ sql.Scanner   = (*Delisle)(nil)
+	_ sql.Scanner   = (*Newton)(nil)
+	_ driver.Valuer = Celsius(0)
+	_ driver.Valuer = Fahrenheit(0)
+	_ driver.Valuer = Kelvin(0)
+	_ driver.Valuer = Rankine(0)
+	_ driver.Valuer = Reaumur(0)
+	_ driver.Valuer = Delisle(0)
+	_ driver.Valuer = Newton(0)
+	_ sql.Scanner   = (*NullCelsius)(nil)
+	_ sql.Scanner   = (*NullFahrenheit)(nil)
+	_ sql.Scanner   = (*NullKelvin)(nil)
+	_ sql.Scanner   = (*NullRankine)(nil)
+	_ sql.Scanner   = (*NullReaumur)(nil)
+	_ sql.Scanner   = (*NullDelisle)(nil)
+	_ sql.Scanner   = (*NullNewton)(nil)
+	_ driver.Valuer = NullCelsius{}
+	_ driver.Valuer = NullFahrenheit{}
+	_ driver.Valuer = NullKelvin{}
+	_ driver.Valuer = NullRankine{}
+	_ driver.Valuer = NullReaumur{}
+	_ driver.Valuer = NullDelisle{}
+	_ driver.Valuer = NullNewton{}
+)
+
+// TestCelsiusScan проверяет чтение температуры из значений, которые
+// возвращают драйверы баз данных.
+func TestCelsiusScan(t *testing.T) {
+	tests := []struct {
+		src      any
+		expected Celsius
+		err      error
+	}{
+		{25.5, 25.5, nil},
+		{int64(-40), -40, nil},
+		{[]byte("36.6"), 36.6, nil},
+		{" -273.15 ", -273.15, nil},
+		{-300.0, 0, ErrBelowAbsoluteZero},
+		{"abc", 0, ErrInvalidSQLValue},
+		{"NaN", 0, ErrInvalidSQLValue},
+		{true, 0, ErrInvalidSQLValue},
+		{nil, 0, ErrInvalidSQLValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("Scan %v", tt.src), func(t *testing.T) {
+			c := Celsius(1)
+			err := c.Scan(tt.src)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if err == nil && c != tt.expected {
+				t.Fatalf("expected %v, got %v", tt.expected, c)
+			}
+			if err != nil && c != 1 {
+				t.Fatalf("value changed on error: %v", c)
+			}
+		})
+	}
+}
+
+// TestScanAllScales проверяет проверку абсолютного нуля при чтении для всех
+// шкал, включая обратную шкалу Делисля.
+func TestScanAllScales(t *testing.T) {
+	tests := []struct {
+		name  string
+		dst   sql.Scanner
+		valid float64
+		below float64
+	}{
+		{"Celsius", new(Celsius), -273.15, -273.16},
+		{"Fahrenheit", new(Fahrenheit), -459.67, -460},
+		{"Kelvin", new(Kelvin), 0, -0.01},
+		{"Rankine", new(Rankine), 0, -0.01},
+		{"Reaumur", new(Reaumur), -218.52, -219},
+		{"Delisle", new(Delisle), 559.725, 560},
+		{"Newton", new(Newton), -90.1395, -91},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.dst.Scan(tt.valid); err != nil {
+				t.Errorf("Scan(%v) unexpected error: %v", tt.valid, err)
+			}
+			if err := tt.dst.Scan(tt.below); !errors.Is(err, ErrBelowAbsoluteZero) {
+				t.Errorf("Scan(%v) error = %v, want %v", tt.below, err, ErrBelowAbsoluteZero)
+			}
+		})
+	}
+}
+
+// TestValue проверяет запись температур и отклонение значений ниже
+// абсолютного нуля.
+func TestValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    driver.Valuer
+		expected driver.Value
+		err      error
+	}{
+		{"Celsius", Celsius(21.5), 21.5, nil},
+		{"Kelvin below zero", Kelvin(-1), nil, ErrBelowAbsoluteZero},
+		{"Delisle below zero", Delisle(600), nil, ErrBelowAbsoluteZero},
+		{"Fahrenheit NaN", Fahrenheit(math.NaN()), nil, ErrInvalidSQLValue},
+		{"Newton", Newton(33), 33.0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := tt.value.Value()
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if v != tt.expected {
+				t.Fatalf("expected %v, got %v", tt.expected, v)
+			}
+		})
+	}
+}
+
+// TestNullKelvin проверяет тип, допускающий NULL.
+func TestNullKelvin(t *testing.T) {
+	n := NullKelvin{Kelvin: 300, Valid: true}
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Fatalf("Scan(nil) = %+v, %v; want invalid, nil", n, err)
+	}
+	if v, err := n.Value(); v != nil || err != nil {
+		t.Errorf("Value() = %v, %v; want nil, nil", v, err)
+	}
+
+	if err := n.Scan(int64(273)); err != nil || !n.Valid || n.Kelvin != 273 {
+		t.Fatalf("Scan(273) = %+v, %v; want {273 true}, nil", n, err)
+	}
+	if v, err := n.Value(); v != 273.0 || err != nil {
+		t.Errorf("Value() = %v, %v; want 273, nil", v, err)
+	}
+
+	if err := n.Scan(-5.0); !errors.Is(err, ErrBelowAbsoluteZero) {
+		t.Errorf("Scan(-5) error = %v, want %v", err, ErrBelowAbsoluteZero)
+	}
+	if _, err := (NullKelvin{Kelvin: -5, Valid: true}).Value(); !errors.Is(err, ErrBelowAbsoluteZero) {
+		t.Errorf("Value() error = %v, want %v", err, ErrBelowAbsoluteZero)
+	}
+}
@@ -0,0 +1,22 @@
+// Пакет stats содержит потоковый накопитель статистики Stats для показаний
+// температуры в любых шкалах.
+//
+// Все показания приводятся к кельвинам, поэтому показания в разных шкалах
+// можно смешивать в одном потоке. Среднее, минимум, максимум и квантили -
+// абсолютные температуры (tempconv.Kelvin), а стандартное отклонение -
+// разность температур (tempconv.Delta): при переводе в другую шкалу у него
+// меняется только цена деления, а не положение нуля. Дисперсия возвращается
+// в K².
+//
+// Квантили оцениваются алгоритмом P² (Jain, Chlamtac, 1985) с постоянным
+// объемом памяти.
+//
+// # Пример использования:
+//
+//	s, _ := stats.New(0.5, 0.95)
+//	_ = s.Add(tempconv.Celsius(20), time.Now())
+//	_ = s.Add(tempconv.Fahrenheit(70), time.Now())
+//	mean, _ := s.Mean()
+//	fmt.Println(mean.ToCelsius())                        // 20.56°C
+//	fmt.Println(s.StdDev().In(tempconv.ScaleFahrenheit)) // Δ1.41°F
+package stats
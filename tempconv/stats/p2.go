@@ -0,0 +1,98 @@
+package stats
+
+import (
+	"math"
+	"slices"
+)
+
+// p2 - оценка квантиля алгоритмом P² по пяти маркерам.
+type p2 struct {
+	p       float64
+	count   int
+	heights [5]float64 // высоты маркеров
+	pos     [5]float64 // фактические позиции маркеров
+	desired [5]float64 // желаемые позиции маркеров
+	inc     [5]float64 // приращения желаемых позиций
+}
+
+// newP2 создает оценку квантиля уровня p.
+func newP2(p float64) *p2 {
+	return &p2{
+		p:       p,
+		pos:     [5]float64{1, 2, 3, 4, 5},
+		desired: [5]float64{1, 1 + 2*p, 1 + 4*p, 3 + 2*p, 5},
+		inc:     [5]float64{0, p / 2, p, (1 + p) / 2, 1},
+	}
+}
+
+// add учитывает очередное наблюдение x.
+func (q *p2) add(x float64) {
+	if q.count < 5 {
+		q.heights[q.count] = x
+		q.count++
+		if q.count == 5 {
+			slices.Sort(q.heights[:])
+		}
+		return
+	}
+	q.count++
+
+	var k int
+	switch {
+	case x < q.heights[0]:
+		q.heights[0] = x
+		k = 0
+	case x >= q.heights[4]:
+		q.heights[4] = x
+		k = 3
+	default:
+		for k = 0; k < 3 && x >= q.heights[k+1]; k++ {
+		}
+	}
+	for i := k + 1; i < 5; i++ {
+		q.pos[i]++
+	}
+	for i := range q.desired {
+		q.desired[i] += q.inc[i]
+	}
+
+	for i := 1; i <= 3; i++ {
+		d := q.desired[i] - q.pos[i]
+		if (d >= 1 && q.pos[i+1]-q.pos[i] > 1) || (d <= -1 && q.pos[i-1]-q.pos[i] < -1) {
+			s := math.Copysign(1, d)
+			h := q.parabolic(i, s)
+			if q.heights[i-1] < h && h < q.heights[i+1] {
+				q.heights[i] = h
+			} else {
+				q.heights[i] = q.linear(i, s)
+			}
+			q.pos[i] += s
+		}
+	}
+}
+
+// parabolic возвращает новую высоту маркера i по кусочно-параболической формуле.
+func (q *p2) parabolic(i int, d float64) float64 {
+	n, h := q.pos, q.heights
+	return h[i] + d/(n[i+1]-n[i-1])*
+		((n[i]-n[i-1]+d)*(h[i+1]-h[i])/(n[i+1]-n[i])+
+			(n[i+1]-n[i]-d)*(h[i]-h[i-1])/(n[i]-n[i-1]))
+}
+
+// linear возвращает новую высоту маркера i по линейной формуле.
+func (q *p2) linear(i int, d float64) float64 {
+	j := i + int(d)
+	return q.heights[i] + d*(q.heights[j]-q.heights[i])/(q.pos[j]-q.pos[i])
+}
+
+// value возвращает текущую оценку квантиля. Пока наблюдений меньше пяти,
+// возвращается точный квантиль по ближайшему рангу.
+func (q *p2) value() float64 {
+	if q.count >= 5 {
+		return q.heights[2]
+	}
+	sorted := slices.Clone(q.heights[:q.count])
+	slices.Sort(sorted)
+	i := int(math.Ceil(q.p*float64(q.count))) - 1
+	return sorted[max(i, 0)]
+}
@@ -0,0 +1,134 @@
+package stats
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// Ошибки накопителя статистики
+var (
+	ErrNoData             = errors.New("нет показаний")
+	ErrInvalidQuantile    = errors.New("недопустимый уровень квантиля")
+	ErrQuantileNotTracked = errors.New("квантиль не отслеживается")
+	ErrNilTemperature     = errors.New("не задана температура")
+)
+
+// Extremum - экстремальное показание и момент его получения.
+type Extremum struct {
+	// Value - значение температуры
+	Value tempconv.Kelvin
+	// Time - момент показания
+	Time time.Time
+}
+
+// Stats - потоковый накопитель статистики показаний температуры. Для
+// среднего и дисперсии используется алгоритм Уэлфорда. Stats не
+// предназначен для одновременного использования из нескольких горутин.
+type Stats struct {
+	count    int
+	mean, m2 float64
+	min, max Extremum
+	quantile []*p2
+}
+
+// New создает накопитель, отслеживающий квантили уровней quantiles из
+// интервала (0, 1).
+func New(quantiles ...float64) (*Stats, error) {
+	s := &Stats{}
+	for _, p := range quantiles {
+		if !(p > 0 && p < 1) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidQuantile, p)
+		}
+		s.quantile = append(s.quantile, newP2(p))
+	}
+	return s, nil
+}
+
+// Add добавляет показание t, полученное в момент at. Показания ниже
+// абсолютного нуля отклоняются.
+func (s *Stats) Add(t tempconv.Temperature, at time.Time) error {
+	if t == nil {
+		return ErrNilTemperature
+	}
+	k, err := tempconv.NewKelvin(float64(t.ToKelvin()))
+	if err != nil {
+		return err
+	}
+	x := float64(k)
+
+	s.count++
+	d := x - s.mean
+	s.mean += d / float64(s.count)
+	s.m2 += d * (x - s.mean)
+
+	if s.count == 1 || k < s.min.Value {
+		s.min = Extremum{Value: k, Time: at}
+	}
+	if s.count == 1 || k > s.max.Value {
+		s.max = Extremum{Value: k, Time: at}
+	}
+	for _, q := range s.quantile {
+		q.add(x)
+	}
+	return nil
+}
+
+// Count возвращает количество показаний.
+func (s *Stats) Count() int { return s.count }
+
+// Mean возвращает среднюю температуру.
+func (s *Stats) Mean() (tempconv.Kelvin, error) {
+	if s.count == 0 {
+		return 0, ErrNoData
+	}
+	return tempconv.Kelvin(s.mean), nil
+}
+
+// Variance возвращает выборочную дисперсию показаний в K². Для менее чем
+// двух показаний возвращается 0.
+func (s *Stats) Variance() float64 {
+	if s.count < 2 {
+		return 0
+	}
+	return s.m2 / float64(s.count-1)
+}
+
+// StdDev возвращает выборочное стандартное отклонение как разность температур
+// в кельвинах. Для перевода в другую шкалу используйте Delta.In.
+func (s *Stats) StdDev() tempconv.Delta {
+	return tempconv.Delta{Value: math.Sqrt(s.Variance()), Scale: tempconv.ScaleKelvin}
+}
+
+// Min возвращает самое низкое показание и момент его получения.
+func (s *Stats) Min() (Extremum, error) {
+	if s.count == 0 {
+		return Extremum{}, ErrNoData
+	}
+	return s.min, nil
+}
+
+// Max возвращает самое высокое показание и момент его получения.
+func (s *Stats) Max() (Extremum, error) {
+	if s.count == 0 {
+		return Extremum{}, ErrNoData
+	}
+	return s.max, nil
+}
+
+// Quantile возвращает оценку квантиля уровня p. Уровень должен быть передан
+// в New.
+func (s *Stats) Quantile(p float64) (tempconv.Kelvin, error) {
+	if s.count == 0 {
+		return 0, ErrNoData
+	}
+	for _, q := range s.quantile {
+		if q.p == p {
+			return tempconv.Kelvin(q.value()), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %v", ErrQuantileNotTracked, p)
+}
@@ -0,0 +1,143 @@
+package stats
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// almostEqual проверяет, что два числа почти равны с заданной погрешностью.
+func almostEqual(a, b float64, epsilon float64) bool {
+	return math.Abs(a-b) <= epsilon
+}
+
+// t0 - момент первого тестового показания
+var t0 = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// TestMixedScales проверяет, что показания в разных шкалах дают ту же
+// статистику, что и показания в одной шкале.
+func TestMixedScales(t *testing.T) {
+	s, _ := New()
+	inputs := []tempconv.Temperature{
+		tempconv.Celsius(10),
+		tempconv.Fahrenheit(68),          // 20°C
+		tempconv.Kelvin(303.15),          // 30°C
+		tempconv.Celsius(40).ToDelisle(), // 40°C
+	}
+	for i, in := range inputs {
+		if err := s.Add(in, t0.Add(time.Duration(i)*time.Minute)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if s.Count() != 4 {
+		t.Errorf("Count() = %d, want 4", s.Count())
+	}
+	mean, err := s.Mean()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := float64(mean.ToCelsius()); !almostEqual(got, 25, 1e-9) {
+		t.Errorf("Mean() = %v°C, want 25°C", got)
+	}
+	// Выборочная дисперсия {10, 20, 30, 40} равна 166.67 K²
+	if got := s.Variance(); !almostEqual(got, 500.0/3, 1e-9) {
+		t.Errorf("Variance() = %v, want %v", got, 500.0/3)
+	}
+
+	lo, _ := s.Min()
+	hi, _ := s.Max()
+	if !almostEqual(float64(lo.Value.ToCelsius()), 10, 1e-9) || !lo.Time.Equal(t0) {
+		t.Errorf("Min() = %v, want 10°C at %v", lo, t0)
+	}
+	if !almostEqual(float64(hi.Value.ToCelsius()), 40, 1e-9) || !hi.Time.Equal(t0.Add(3*time.Minute)) {
+		t.Errorf("Max() = %v, want 40°C at %v", hi, t0.Add(3*time.Minute))
+	}
+}
+
+// TestStdDevIsDelta проверяет, что стандартное отклонение переводится между
+// шкалами как разность температур, а не как абсолютная температура.
+func TestStdDevIsDelta(t *testing.T) {
+	s, _ := New()
+	for _, c := range []float64{18, 22} {
+		_ = s.Add(tempconv.Celsius(c), t0)
+	}
+	sd := s.StdDev()
+	want := math.Sqrt(8)
+	if !almostEqual(sd.In(tempconv.ScaleCelsius).Value, want, 1e-9) {
+		t.Errorf("StdDev() = %v, want %v K", sd, want)
+	}
+	if got := sd.In(tempconv.ScaleFahrenheit).Value; !almostEqual(got, want*9/5, 1e-9) {
+		t.Errorf("StdDev().In(F) = %v, want %v", got, want*9/5)
+	}
+}
+
+// TestQuantiles проверяет оценку квантилей алгоритмом P².
+func TestQuantiles(t *testing.T) {
+	s, err := New(0.5, 0.9, 0.99)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rng := rand.New(rand.NewSource(1))
+	values := make([]float64, 10000)
+	for i := range values {
+		values[i] = 20 + 5*rng.NormFloat64()
+		_ = s.Add(tempconv.Celsius(values[i]), t0)
+	}
+	slices.Sort(values)
+
+	for _, p := range []float64{0.5, 0.9, 0.99} {
+		got, err := s.Quantile(p)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		exact := values[int(p*float64(len(values)))]
+		if c := float64(got.ToCelsius()); !almostEqual(c, exact, 0.2) {
+			t.Errorf("Quantile(%v) = %v°C, want about %v°C", p, c, exact)
+		}
+	}
+}
+
+// TestSmallSample проверяет квантили при менее чем пяти наблюдениях.
+func TestSmallSample(t *testing.T) {
+	s, _ := New(0.5)
+	for _, k := range []float64{300, 100, 200} {
+		_ = s.Add(tempconv.Kelvin(k), t0)
+	}
+	if got, _ := s.Quantile(0.5); got != 200 {
+		t.Errorf("Quantile(0.5) = %v, want 200K", got)
+	}
+}
+
+// TestErrors проверяет обработку ошибок.
+func TestErrors(t *testing.T) {
+	if _, err := New(1); !errors.Is(err, ErrInvalidQuantile) {
+		t.Errorf("expected error %v, got %v", ErrInvalidQuantile, err)
+	}
+
+	s, _ := New(0.5)
+	if _, err := s.Mean(); !errors.Is(err, ErrNoData) {
+		t.Errorf("expected error %v, got %v", ErrNoData, err)
+	}
+	if _, err := s.Min(); !errors.Is(err, ErrNoData) {
+		t.Errorf("expected error %v, got %v", ErrNoData, err)
+	}
+	if err := s.Add(tempconv.Celsius(-300), t0); !errors.Is(err, tempconv.ErrBelowAbsoluteZero) {
+		t.Errorf("expected error %v, got %v", tempconv.ErrBelowAbsoluteZero, err)
+	}
+	if err := s.Add(nil, t0); !errors.Is(err, ErrNilTemperature) {
+		t.Errorf("expected error %v, got %v", ErrNilTemperature, err)
+	}
+	_ = s.Add(tempconv.Celsius(0), t0)
+	if _, err := s.Quantile(0.9); !errors.Is(err, ErrQuantileNotTracked) {
+		t.Errorf("expected error %v, got %v", ErrQuantileNotTracked, err)
+	}
+	if s.Count() != 1 {
+		t.Errorf("Count() = %d, want 1: rejected readings must not be counted", s.Count())
+	}
+}
@@ -8,6 +8,11 @@ import (
 // Ошибки для недопустимых температур
 var (
 	ErrBelowAbsoluteZero = errors.New("температура ниже абсолютного нуля")
+	ErrUnknownScale      = errors.New("неизвестная температурная шкала")
+	ErrInvalidSQLValue   = errors.New("недопустимое значение температуры в базе данных")
+	ErrInvalidFormat     = errors.New("неверный формат температуры")
+	ErrInvalidBinary     = errors.New("недопустимое двоичное представление температуры")
+	ErrEncodingRange     = errors.New("значение не помещается в выбранное кодирование")
 )
 
 // Константы для температурных точек
@@ -287,7 +292,7 @@ func (r Rankine) ToRankine() Rankine { return r }
 
 // ToCelsius преобразует температуру из Ранкина в Цельсий.
 // Метод возвращает объект типа Celsius, который представляет температуру в шкале Цельсия.
-func (r Rankine) ToCelsius() Celsius { return Celsius((r - rToFOffset) * rToCMultiplier) }
+func (r Rankine) ToCelsius() Celsius { return Celsius((r - rToFOffset - fToCOffset) * rToCMultiplier) }
 
 // ToFahrenheit преобразует температуру из Ранкина в Фаренгейт.
 // Метод возвращает объект типа Fahrenheit, который представляет температуру в шкале Фаренгейта.
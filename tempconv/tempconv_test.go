@@ -241,6 +241,26 @@ func TestConversions(t *testing.T) {
 	}
 }
 
+// TestRankineToCelsius проверяет обратное преобразование из Ранкина в Цельсий.
+func TestRankineToCelsius(t *testing.T) {
+	tests := []struct {
+		input    Rankine
+		expected Celsius
+	}{
+		{0, -273.15},
+		{491.67, 0},
+		{671.67, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("Rankine %v", tt.input), func(t *testing.T) {
+			if got := float64(tt.input.ToCelsius()); !almostEqual(got, float64(tt.expected), 0.01) {
+				t.Errorf("ToCelsius() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
 // TestInvalidTemperatures проверяет обработку ошибок для температур ниже
 // абсолютного нуля.
 func TestInvalidTemperatures(t *testing.T) {
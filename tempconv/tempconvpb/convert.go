@@ -0,0 +1,86 @@
+package tempconvpb
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// ErrNilMessage - сообщение не задано
+var ErrNilMessage = errors.New("не задано сообщение")
+
+// ScaleToProto возвращает значение перечисления Scale для шкалы s. Для
+// недопустимой шкалы возвращается SCALE_UNSPECIFIED.
+func ScaleToProto(s tempconv.Scale) Scale {
+	if !s.Valid() {
+		return Scale_SCALE_UNSPECIFIED
+	}
+	return Scale(s)
+}
+
+// ScaleFromProto возвращает шкалу для значения перечисления Scale.
+func ScaleFromProto(s Scale) (tempconv.Scale, error) {
+	scale := tempconv.Scale(s)
+	if !scale.Valid() {
+		return 0, fmt.Errorf("%w: %v", tempconv.ErrUnknownScale, s)
+	}
+	return scale, nil
+}
+
+// ToProto возвращает сообщение для температуры t в ее собственной шкале или
+// nil, если температура не задана.
+func ToProto(t tempconv.Temperature) *Temperature {
+	if t == nil {
+		return nil
+	}
+	return &Temperature{Value: tempconv.ValueOf(t), Scale: ScaleToProto(tempconv.ScaleOf(t))}
+}
+
+// FromProto возвращает температуру из сообщения p. Температура создается
+// конструктором соответствующей шкалы (NewCelsius, NewKelvin и т.д.), поэтому
+// значения ниже абсолютного нуля отклоняются с ошибкой ErrBelowAbsoluteZero,
+// а NaN и бесконечности - с ошибкой ErrInvalidFormat.
+func FromProto(p *Temperature) (tempconv.Temperature, error) {
+	if p == nil {
+		return nil, ErrNilMessage
+	}
+	scale, err := ScaleFromProto(p.GetScale())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkFinite(p.GetValue()); err != nil {
+		return nil, err
+	}
+	return scale.New(p.GetValue())
+}
+
+// DeltaToProto возвращает сообщение для разности температур d.
+func DeltaToProto(d tempconv.Delta) *TemperatureDelta {
+	return &TemperatureDelta{Value: d.Value, Scale: ScaleToProto(d.Scale)}
+}
+
+// DeltaFromProto возвращает разность температур из сообщения p. NaN и
+// бесконечности отклоняются с ошибкой ErrInvalidFormat.
+func DeltaFromProto(p *TemperatureDelta) (tempconv.Delta, error) {
+	if p == nil {
+		return tempconv.Delta{}, ErrNilMessage
+	}
+	scale, err := ScaleFromProto(p.GetScale())
+	if err != nil {
+		return tempconv.Delta{}, err
+	}
+	if err := checkFinite(p.GetValue()); err != nil {
+		return tempconv.Delta{}, err
+	}
+	return tempconv.NewDelta(p.GetValue(), scale)
+}
+
+// checkFinite проверяет, что значение из сообщения - конечное число.
+func checkFinite(v float64) error {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return fmt.Errorf("%w: %v", tempconv.ErrInvalidFormat, v)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package tempconvpb
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestScaleNumbers проверяет, что номера перечисления совпадают с
+// константами tempconv.Scale.
+func TestScaleNumbers(t *testing.T) {
+	for _, s := range tempconv.Scales() {
+		p := ScaleToProto(s)
+		if p == Scale_SCALE_UNSPECIFIED {
+			t.Fatalf("ScaleToProto(%v) = SCALE_UNSPECIFIED", s)
+		}
+		back, err := ScaleFromProto(p)
+		if err != nil || back != s {
+			t.Errorf("ScaleFromProto(%v) = %v, %v; want %v", p, back, err, s)
+		}
+	}
+	if p := ScaleToProto(0); p != Scale_SCALE_UNSPECIFIED {
+		t.Errorf("ScaleToProto(0) = %v, want SCALE_UNSPECIFIED", p)
+	}
+}
+
+// TestRoundTrip проверяет передачу температур через сериализованное
+// сообщение для всех шкал.
+func TestRoundTrip(t *testing.T) {
+	inputs := []tempconv.Temperature{
+		tempconv.Celsius(21.5), tempconv.Fahrenheit(-40), tempconv.Kelvin(0),
+		tempconv.Rankine(491.67), tempconv.Reaumur(80), tempconv.Delisle(559.725),
+		tempconv.Newton(33),
+	}
+
+	for _, in := range inputs {
+		t.Run(in.ScaleName(), func(t *testing.T) {
+			data, err := proto.Marshal(ToProto(in))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var msg Temperature
+			if err := proto.Unmarshal(data, &msg); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got, err := FromProto(&msg)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != in {
+				t.Errorf("FromProto() = %v (%s), want %v", got, got.ScaleName(), in)
+			}
+		})
+	}
+}
+
+// TestFromProtoErrors проверяет проверку сообщений при разборе.
+func TestFromProtoErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *Temperature
+		err  error
+	}{
+		{"nil", nil, ErrNilMessage},
+		{"unspecified scale", &Temperature{Value: 20}, tempconv.ErrUnknownScale},
+		{"unknown scale", &Temperature{Value: 20, Scale: 42}, tempconv.ErrUnknownScale},
+		{"below absolute zero", &Temperature{Value: -1, Scale: Scale_SCALE_KELVIN}, tempconv.ErrBelowAbsoluteZero},
+		{"Delisle above limit", &Temperature{Value: 600, Scale: Scale_SCALE_DELISLE}, tempconv.ErrBelowAbsoluteZero},
+		{"NaN", &Temperature{Value: math.NaN(), Scale: Scale_SCALE_CELSIUS}, tempconv.ErrInvalidFormat},
+		{"+Inf", &Temperature{Value: math.Inf(1), Scale: Scale_SCALE_FAHRENHEIT}, tempconv.ErrInvalidFormat},
+		{"-Inf Delisle", &Temperature{Value: math.Inf(-1), Scale: Scale_SCALE_DELISLE}, tempconv.ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := FromProto(tt.msg); !errors.Is(err, tt.err) {
+				t.Errorf("FromProto() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+	if ToProto(nil) != nil {
+		t.Error("ToProto(nil) != nil")
+	}
+}
+
+// TestDelta проверяет передачу разностей температур.
+func TestDelta(t *testing.T) {
+	d := tempconv.Delta{Value: 18, Scale: tempconv.ScaleFahrenheit}
+	got, err := DeltaFromProto(DeltaToProto(d))
+	if err != nil || got != d {
+		t.Errorf("DeltaFromProto() = %v, %v; want %v", got, err, d)
+	}
+	if _, err := DeltaFromProto(&TemperatureDelta{Value: 1}); !errors.Is(err, tempconv.ErrUnknownScale) {
+		t.Errorf("DeltaFromProto() error = %v, want %v", err, tempconv.ErrUnknownScale)
+	}
+	for _, v := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		msg := &TemperatureDelta{Value: v, Scale: Scale_SCALE_KELVIN}
+		if _, err := DeltaFromProto(msg); !errors.Is(err, tempconv.ErrInvalidFormat) {
+			t.Errorf("DeltaFromProto(%v) error = %v, want %v", v, err, tempconv.ErrInvalidFormat)
+		}
+	}
+	if _, err := DeltaFromProto(nil); !errors.Is(err, ErrNilMessage) {
+		t.Errorf("DeltaFromProto(nil) error = %v, want %v", err, ErrNilMessage)
+	}
+}
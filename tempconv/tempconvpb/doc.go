@@ -0,0 +1,21 @@
+// Пакет tempconvpb содержит сообщения Protocol Buffers для передачи
+// температур (Temperature) и разностей температур (TemperatureDelta), а также
+// функции преобразования между сообщениями и типами пакета tempconv.
+//
+// Схема находится в proto/tempconv/v1/temperature.proto. Значение передается
+// в той шкале, в которой оно было задано; при разборе сообщения температура
+// создается через конструкторы New* и проверяется на абсолютный ноль, а
+// NaN и бесконечности в температурах и разностях отклоняются.
+//
+// # Пример использования:
+//
+//	msg := tempconvpb.ToProto(tempconv.Fahrenheit(350))
+//	t, err := tempconvpb.FromProto(msg)
+//	if err != nil {
+//	    fmt.Println("Ошибка:", err)
+//	    return
+//	}
+//	fmt.Println(t) // 350.00°F
+package tempconvpb
+
+//go:generate protoc -I ../../proto --go_out=../.. --go_opt=module=github.com/MiCkEyZzZ/tempconv tempconv/v1/temperature.proto
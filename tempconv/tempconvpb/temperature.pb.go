@@ -0,0 +1,287 @@
+// Схема передачи температур и разностей температур.
+//
+// Значение передается в той шкале, в которой оно было задано; номера
+// значений Scale совпадают с константами tempconv.Scale.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: tempconv/v1/temperature.proto
+
+package tempconvpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Scale - температурная шкала.
+type Scale int32
+
+const (
+	// Шкала не указана; такое значение недопустимо.
+	Scale_SCALE_UNSPECIFIED Scale = 0
+	// Шкала Цельсия (°C).
+	Scale_SCALE_CELSIUS Scale = 1
+	// Шкала Фаренгейта (°F).
+	Scale_SCALE_FAHRENHEIT Scale = 2
+	// Шкала Кельвина (K).
+	Scale_SCALE_KELVIN Scale = 3
+	// Шкала Ранкина (°R).
+	Scale_SCALE_RANKINE Scale = 4
+	// Шкала Реомюра (°Re).
+	Scale_SCALE_REAUMUR Scale = 5
+	// Шкала Делисля (°De).
+	Scale_SCALE_DELISLE Scale = 6
+	// Шкала Ньютона (°N).
+	Scale_SCALE_NEWTON Scale = 7
+)
+
+// Enum value maps for Scale.
+var (
+	Scale_name = map[int32]string{
+		0: "SCALE_UNSPECIFIED",
+		1: "SCALE_CELSIUS",
+		2: "SCALE_FAHRENHEIT",
+		3: "SCALE_KELVIN",
+		4: "SCALE_RANKINE",
+		5: "SCALE_REAUMUR",
+		6: "SCALE_DELISLE",
+		7: "SCALE_NEWTON",
+	}
+	Scale_value = map[string]int32{
+		"SCALE_UNSPECIFIED": 0,
+		"SCALE_CELSIUS":     1,
+		"SCALE_FAHRENHEIT":  2,
+		"SCALE_KELVIN":      3,
+		"SCALE_RANKINE":     4,
+		"SCALE_REAUMUR":     5,
+		"SCALE_DELISLE":     6,
+		"SCALE_NEWTON":      7,
+	}
+)
+
+func (x Scale) Enum() *Scale {
+	p := new(Scale)
+	*p = x
+	return p
+}
+
+func (x Scale) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Scale) Descriptor() protoreflect.EnumDescriptor {
+	return file_tempconv_v1_temperature_proto_enumTypes[0].Descriptor()
+}
+
+func (Scale) Type() protoreflect.EnumType {
+	return &file_tempconv_v1_temperature_proto_enumTypes[0]
+}
+
+func (x Scale) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Scale.Descriptor instead.
+func (Scale) EnumDescriptor() ([]byte, []int) {
+	return file_tempconv_v1_temperature_proto_rawDescGZIP(), []int{0}
+}
+
+// Temperature - температура в заданной шкале. Значение не может быть ниже
+// абсолютного нуля шкалы.
+type Temperature struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Числовое значение в шкале scale.
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Шкала значения.
+	Scale         Scale `protobuf:"varint,2,opt,name=scale,proto3,enum=tempconv.v1.Scale" json:"scale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Temperature) Reset() {
+	*x = Temperature{}
+	mi := &file_tempconv_v1_temperature_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Temperature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Temperature) ProtoMessage() {}
+
+func (x *Temperature) ProtoReflect() protoreflect.Message {
+	mi := &file_tempconv_v1_temperature_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Temperature.ProtoReflect.Descriptor instead.
+func (*Temperature) Descriptor() ([]byte, []int) {
+	return file_tempconv_v1_temperature_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Temperature) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Temperature) GetScale() Scale {
+	if x != nil {
+		return x.Scale
+	}
+	return Scale_SCALE_UNSPECIFIED
+}
+
+// TemperatureDelta - разность температур (температурный интервал) в заданной
+// шкале. При переводе в другую шкалу учитывается только цена деления.
+type TemperatureDelta struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Величина разности в градусах шкалы scale.
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Шкала разности.
+	Scale         Scale `protobuf:"varint,2,opt,name=scale,proto3,enum=tempconv.v1.Scale" json:"scale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemperatureDelta) Reset() {
+	*x = TemperatureDelta{}
+	mi := &file_tempconv_v1_temperature_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemperatureDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemperatureDelta) ProtoMessage() {}
+
+func (x *TemperatureDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_tempconv_v1_temperature_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemperatureDelta.ProtoReflect.Descriptor instead.
+func (*TemperatureDelta) Descriptor() ([]byte, []int) {
+	return file_tempconv_v1_temperature_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TemperatureDelta) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *TemperatureDelta) GetScale() Scale {
+	if x != nil {
+		return x.Scale
+	}
+	return Scale_SCALE_UNSPECIFIED
+}
+
+var File_tempconv_v1_temperature_proto protoreflect.FileDescriptor
+
+const file_tempconv_v1_temperature_proto_rawDesc = "" +
+	"\n" +
+	"\x1dtempconv/v1/temperature.proto\x12\vtempconv.v1\"M\n" +
+	"\vTemperature\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12(\n" +
+	"\x05scale\x18\x02 \x01(\x0e2\x12.tempconv.v1.ScaleR\x05scale\"R\n" +
+	"\x10TemperatureDelta\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12(\n" +
+	"\x05scale\x18\x02 \x01(\x0e2\x12.tempconv.v1.ScaleR\x05scale*\xa4\x01\n" +
+	"\x05Scale\x12\x15\n" +
+	"\x11SCALE_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rSCALE_CELSIUS\x10\x01\x12\x14\n" +
+	"\x10SCALE_FAHRENHEIT\x10\x02\x12\x10\n" +
+	"\fSCALE_KELVIN\x10\x03\x12\x11\n" +
+	"\rSCALE_RANKINE\x10\x04\x12\x11\n" +
+	"\rSCALE_REAUMUR\x10\x05\x12\x11\n" +
+	"\rSCALE_DELISLE\x10\x06\x12\x10\n" +
+	"\fSCALE_NEWTON\x10\aB>Z<github.com/MiCkEyZzZ/tempconv/tempconv/tempconvpb;tempconvpbb\x06proto3"
+
+var (
+	file_tempconv_v1_temperature_proto_rawDescOnce sync.Once
+	file_tempconv_v1_temperature_proto_rawDescData []byte
+)
+
+func file_tempconv_v1_temperature_proto_rawDescGZIP() []byte {
+	file_tempconv_v1_temperature_proto_rawDescOnce.Do(func() {
+		file_tempconv_v1_temperature_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tempconv_v1_temperature_proto_rawDesc), len(file_tempconv_v1_temperature_proto_rawDesc)))
+	})
+	return file_tempconv_v1_temperature_proto_rawDescData
+}
+
+var file_tempconv_v1_temperature_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tempconv_v1_temperature_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_tempconv_v1_temperature_proto_goTypes = []any{
+	(Scale)(0),               // 0: tempconv.v1.Scale
+	(*Temperature)(nil),      // 1: tempconv.v1.Temperature
+	(*TemperatureDelta)(nil), // 2: tempconv.v1.TemperatureDelta
+}
+var file_tempconv_v1_temperature_proto_depIdxs = []int32{
+	0, // 0: tempconv.v1.Temperature.scale:type_name -> tempconv.v1.Scale
+	0, // 1: tempconv.v1.TemperatureDelta.scale:type_name -> tempconv.v1.Scale
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_tempconv_v1_temperature_proto_init() }
+func file_tempconv_v1_temperature_proto_init() {
+	if File_tempconv_v1_temperature_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tempconv_v1_temperature_proto_rawDesc), len(file_tempconv_v1_temperature_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tempconv_v1_temperature_proto_goTypes,
+		DependencyIndexes: file_tempconv_v1_temperature_proto_depIdxs,
+		EnumInfos:         file_tempconv_v1_temperature_proto_enumTypes,
+		MessageInfos:      file_tempconv_v1_temperature_proto_msgTypes,
+	}.Build()
+	File_tempconv_v1_temperature_proto = out.File
+	file_tempconv_v1_temperature_proto_goTypes = nil
+	file_tempconv_v1_temperature_proto_depIdxs = nil
+}
@@ -0,0 +1,319 @@
+package tempconv
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Разбор температур из текстовых форматов конфигурации. Все типы температур и
+// AnyTemperature принимают как числа без обозначения шкалы, так и строки с
+// обозначением шкалы ("85°C", "185 F", "300K"):
+//
+//   - UnmarshalText - для encoding.TextUnmarshaler (TOML, YAML, переменные
+//     окружения и другие форматы, передающие значение строкой);
+//   - UnmarshalJSON - число или строка JSON;
+//   - UnmarshalYAML - интерфейс Unmarshaler пакетов gopkg.in/yaml.v2 и
+//     gopkg.in/yaml.v3;
+//   - UnmarshalTOML - интерфейс Unmarshaler пакета github.com/BurntSushi/toml.
+//
+// Число без обозначения шкалы считается заданным в шкале получателя (для
+// AnyTemperature - в шкале поля DefaultScale). Значение проверяется на
+// абсолютный ноль в той шкале, в которой оно задано, и преобразуется в шкалу
+// получателя.
+//
+// MarshalJSON записывает температуру числом, которое разбирается обратно без
+// изменений. NaN и бесконечности в JSON непредставимы и отклоняются с ошибкой
+// ErrEncodingRange, которую можно проверить через errors.Is.
+
+// UnmarshalText разбирает температуру из текста и преобразует ее в шкалу Цельсия.
+func (c *Celsius) UnmarshalText(text []byte) error {
+	return decodeText(c, string(text), ScaleCelsius, Temperature.ToCelsius)
+}
+
+// MarshalJSON записывает температуру в шкале Цельсия числом JSON.
+func (c Celsius) MarshalJSON() ([]byte, error) { return marshalJSON(float64(c)) }
+
+// UnmarshalJSON разбирает температуру из числа или строки JSON и
+// преобразует ее в шкалу Цельсия.
+func (c *Celsius) UnmarshalJSON(data []byte) error {
+	return decodeJSON(c, data, ScaleCelsius, Temperature.ToCelsius)
+}
+
+// UnmarshalYAML разбирает температуру из скалярного значения YAML и
+// преобразует ее в шкалу Цельсия.
+func (c *Celsius) UnmarshalYAML(unmarshal func(any) error) error {
+	return decodeYAML(c, unmarshal, ScaleCelsius, Temperature.ToCelsius)
+}
+
+// UnmarshalTOML разбирает температуру из значения TOML и преобразует ее в
+// шкалу Цельсия.
+func (c *Celsius) UnmarshalTOML(v any) error {
+	return decodeTOML(c, v, ScaleCelsius, Temperature.ToCelsius)
+}
+
+// UnmarshalText разбирает температуру из текста и преобразует ее в шкалу Фаренгейта.
+func (f *Fahrenheit) UnmarshalText(text []byte) error {
+	return decodeText(f, string(text), ScaleFahrenheit, Temperature.ToFahrenheit)
+}
+
+// MarshalJSON записывает температуру в шкале Фаренгейта числом JSON.
+func (f Fahrenheit) MarshalJSON() ([]byte, error) { return marshalJSON(float64(f)) }
+
+// UnmarshalJSON разбирает температуру из числа или строки JSON и
+// преобразует ее в шкалу Фаренгейта.
+func (f *Fahrenheit) UnmarshalJSON(data []byte) error {
+	return decodeJSON(f, data, ScaleFahrenheit, Temperature.ToFahrenheit)
+}
+
+// UnmarshalYAML разбирает температуру из скалярного значения YAML и
+// преобразует ее в шкалу Фаренгейта.
+func (f *Fahrenheit) UnmarshalYAML(unmarshal func(any) error) error {
+	return decodeYAML(f, unmarshal, ScaleFahrenheit, Temperature.ToFahrenheit)
+}
+
+// UnmarshalTOML разбирает температуру из значения TOML и преобразует ее в
+// шкалу Фаренгейта.
+func (f *Fahrenheit) UnmarshalTOML(v any) error {
+	return decodeTOML(f, v, ScaleFahrenheit, Temperature.ToFahrenheit)
+}
+
+// UnmarshalText разбирает температуру из текста и преобразует ее в шкалу Кельвина.
+func (k *Kelvin) UnmarshalText(text []byte) error {
+	return decodeText(k, string(text), ScaleKelvin, Temperature.ToKelvin)
+}
+
+// MarshalJSON записывает температуру в шкале Кельвина числом JSON.
+func (k Kelvin) MarshalJSON() ([]byte, error) { return marshalJSON(float64(k)) }
+
+// UnmarshalJSON разбирает температуру из числа или строки JSON и
+// преобразует ее в шкалу Кельвина.
+func (k *Kelvin) UnmarshalJSON(data []byte) error {
+	return decodeJSON(k, data, ScaleKelvin, Temperature.ToKelvin)
+}
+
+// UnmarshalYAML разбирает температуру из скалярного значения YAML и
+// преобразует ее в шкалу Кельвина.
+func (k *Kelvin) UnmarshalYAML(unmarshal func(any) error) error {
+	return decodeYAML(k, unmarshal, ScaleKelvin, Temperature.ToKelvin)
+}
+
+// UnmarshalTOML разбирает температуру из значения TOML и преобразует ее в
+// шкалу Кельвина.
+func (k *Kelvin) UnmarshalTOML(v any) error {
+	return decodeTOML(k, v, ScaleKelvin, Temperature.ToKelvin)
+}
+
+// UnmarshalText разбирает температуру из текста и преобразует ее в шкалу Ранкина.
+func (r *Rankine) UnmarshalText(text []byte) error {
+	return decodeText(r, string(text), ScaleRankine, Temperature.ToRankine)
+}
+
+// MarshalJSON записывает температуру в шкале Ранкина числом JSON.
+func (r Rankine) MarshalJSON() ([]byte, error) { return marshalJSON(float64(r)) }
+
+// UnmarshalJSON разбирает температуру из числа или строки JSON и
+// преобразует ее в шкалу Ранкина.
+func (r *Rankine) UnmarshalJSON(data []byte) error {
+	return decodeJSON(r, data, ScaleRankine, Temperature.ToRankine)
+}
+
+// UnmarshalYAML разбирает температуру из скалярного значения YAML и
+// преобразует ее в шкалу Ранкина.
+func (r *Rankine) UnmarshalYAML(unmarshal func(any) error) error {
+	return decodeYAML(r, unmarshal, ScaleRankine, Temperature.ToRankine)
+}
+
+// UnmarshalTOML разбирает температуру из значения TOML и преобразует ее в
+// шкалу Ранкина.
+func (r *Rankine) UnmarshalTOML(v any) error {
+	return decodeTOML(r, v, ScaleRankine, Temperature.ToRankine)
+}
+
+// UnmarshalText разбирает температуру из текста и преобразует ее в шкалу Реомюра.
+func (re *Reaumur) UnmarshalText(text []byte) error {
+	return decodeText(re, string(text), ScaleReaumur, Temperature.ToReaumur)
+}
+
+// MarshalJSON записывает температуру в шкале Реомюра числом JSON.
+func (re Reaumur) MarshalJSON() ([]byte, error) { return marshalJSON(float64(re)) }
+
+// UnmarshalJSON разбирает температуру из числа или строки JSON и
+// преобразует ее в шкалу Реомюра.
+func (re *Reaumur) UnmarshalJSON(data []byte) error {
+	return decodeJSON(re, data, ScaleReaumur, Temperature.ToReaumur)
+}
+
+// UnmarshalYAML разбирает температуру из скалярного значения YAML и
+// преобразует ее в шкалу Реомюра.
+func (re *Reaumur) UnmarshalYAML(unmarshal func(any) error) error {
+	return decodeYAML(re, unmarshal, ScaleReaumur, Temperature.ToReaumur)
+}
+
+// UnmarshalTOML разбирает температуру из значения TOML и преобразует ее в
+// шкалу Реомюра.
+func (re *Reaumur) UnmarshalTOML(v any) error {
+	return decodeTOML(re, v, ScaleReaumur, Temperature.ToReaumur)
+}
+
+// UnmarshalText разбирает температуру из текста и преобразует ее в шкалу Делисля.
+func (de *Delisle) UnmarshalText(text []byte) error {
+	return decodeText(de, string(text), ScaleDelisle, Temperature.ToDelisle)
+}
+
+// MarshalJSON записывает температуру в шкале Делисля числом JSON.
+func (de Delisle) MarshalJSON() ([]byte, error) { return marshalJSON(float64(de)) }
+
+// UnmarshalJSON разбирает температуру из числа или строки JSON и
+// преобразует ее в шкалу Делисля.
+func (de *Delisle) UnmarshalJSON(data []byte) error {
+	return decodeJSON(de, data, ScaleDelisle, Temperature.ToDelisle)
+}
+
+// UnmarshalYAML разбирает температуру из скалярного значения YAML и
+// преобразует ее в шкалу Делисля.
+func (de *Delisle) UnmarshalYAML(unmarshal func(any) error) error {
+	return decodeYAML(de, unmarshal, ScaleDelisle, Temperature.ToDelisle)
+}
+
+// UnmarshalTOML разбирает температуру из значения TOML и преобразует ее в
+// шкалу Делисля.
+func (de *Delisle) UnmarshalTOML(v any) error {
+	return decodeTOML(de, v, ScaleDelisle, Temperature.ToDelisle)
+}
+
+// UnmarshalText разбирает температуру из текста и преобразует ее в шкалу Ньютона.
+func (n *Newton) UnmarshalText(text []byte) error {
+	return decodeText(n, string(text), ScaleNewton, Temperature.ToNewton)
+}
+
+// MarshalJSON записывает температуру в шкале Ньютона числом JSON.
+func (n Newton) MarshalJSON() ([]byte, error) { return marshalJSON(float64(n)) }
+
+// UnmarshalJSON разбирает температуру из числа или строки JSON и
+// преобразует ее в шкалу Ньютона.
+func (n *Newton) UnmarshalJSON(data []byte) error {
+	return decodeJSON(n, data, ScaleNewton, Temperature.ToNewton)
+}
+
+// UnmarshalYAML разбирает температуру из скалярного значения YAML и
+// преобразует ее в шкалу Ньютона.
+func (n *Newton) UnmarshalYAML(unmarshal func(any) error) error {
+	return decodeYAML(n, unmarshal, ScaleNewton, Temperature.ToNewton)
+}
+
+// UnmarshalTOML разбирает температуру из значения TOML и преобразует ее в
+// шкалу Ньютона.
+func (n *Newton) UnmarshalTOML(v any) error {
+	return decodeTOML(n, v, ScaleNewton, Temperature.ToNewton)
+}
+
+// MarshalText возвращает текстовое представление температуры со шкалой
+// (см. FormatTemperature) или пустую строку, если температура не задана.
+// NaN и бесконечности отклоняются с ошибкой ErrEncodingRange.
+func (a AnyTemperature) MarshalText() ([]byte, error) {
+	if a.Temperature == nil {
+		return []byte{}, nil
+	}
+	if v := ValueOf(a.Temperature); math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil, fmt.Errorf("%w: %v", ErrEncodingRange, v)
+	}
+	if _, err := a.Value(); err != nil {
+		return nil, err
+	}
+	return []byte(FormatTemperature(a.Temperature)), nil
+}
+
+// UnmarshalText разбирает температуру со шкалой из текста. Пустой текст дает
+// незаданную температуру.
+func (a *AnyTemperature) UnmarshalText(text []byte) error {
+	if len(bytes.TrimSpace(text)) == 0 {
+		a.Temperature = nil
+		return nil
+	}
+	return decodeText(&a.Temperature, string(text), a.defaultScale(), asTemperature)
+}
+
+// UnmarshalJSON разбирает температуру со шкалой из числа или строки JSON.
+// null дает незаданную температуру.
+func (a *AnyTemperature) UnmarshalJSON(data []byte) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		a.Temperature = nil
+		return nil
+	}
+	return decodeJSON(&a.Temperature, data, a.defaultScale(), asTemperature)
+}
+
+// UnmarshalYAML разбирает температуру со шкалой из скалярного значения YAML.
+func (a *AnyTemperature) UnmarshalYAML(unmarshal func(any) error) error {
+	return decodeYAML(&a.Temperature, unmarshal, a.defaultScale(), asTemperature)
+}
+
+// UnmarshalTOML разбирает температуру со шкалой из значения TOML.
+func (a *AnyTemperature) UnmarshalTOML(v any) error {
+	return decodeTOML(&a.Temperature, v, a.defaultScale(), asTemperature)
+}
+
+// marshalJSON записывает конечное значение температуры числом JSON.
+func marshalJSON(v float64) ([]byte, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil, fmt.Errorf("%w: %v в JSON", ErrEncodingRange, v)
+	}
+	return json.Marshal(v)
+}
+
+// asTemperature возвращает температуру без преобразования шкалы.
+func asTemperature(t Temperature) Temperature { return t }
+
+// decodeText разбирает текст s и сохраняет результат преобразования conv в
+// dst. Числа без обозначения шкалы считаются заданными в шкале scale.
+func decodeText[T any](dst *T, s string, scale Scale, conv func(Temperature) T) error {
+	t, err := ParseTemperatureIn(s, scale)
+	if err != nil {
+		return err
+	}
+	*dst = conv(t)
+	return nil
+}
+
+// decodeJSON разбирает число или строку JSON. null оставляет dst без
+// изменений, как и для обычных чисел.
+func decodeJSON[T any](dst *T, data []byte, scale Scale, conv func(Temperature) T) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case string(data) == "null":
+		return nil
+	case len(data) > 0 && data[0] == '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		return decodeText(dst, s, scale, conv)
+	}
+	return decodeText(dst, string(data), scale, conv)
+}
+
+// decodeYAML разбирает скалярное значение YAML.
+func decodeYAML[T any](dst *T, unmarshal func(any) error, scale Scale, conv func(Temperature) T) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return decodeText(dst, s, scale, conv)
+}
+
+// decodeTOML разбирает значение TOML: целое число, число с плавающей точкой
+// или строку.
+func decodeTOML[T any](dst *T, v any, scale Scale, conv func(Temperature) T) error {
+	switch x := v.(type) {
+	case string:
+		return decodeText(dst, x, scale, conv)
+	case int64:
+		return decodeText(dst, strconv.FormatInt(x, 10), scale, conv)
+	case float64:
+		return decodeText(dst, strconv.FormatFloat(x, 'g', -1, 64), scale, conv)
+	}
+	return fmt.Errorf("%w: тип %T", ErrInvalidFormat, v)
+}
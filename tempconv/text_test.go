@@ -0,0 +1,238 @@
+package tempconv
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+// Проверка реализации интерфейсов encoding и json
+var (
+	_ encoding.TextUnmarshaler = (*Celsius)(nil)
+	_ encoding.TextUnmarshaler = (*Newton)(nil)
+	_ encoding.TextUnmarshaler = (*AnyTemperature)(nil)
+	_ encoding.TextMarshaler   = AnyTemperature{}
+	_ json.Unmarshaler         = (*Kelvin)(nil)
+	_ json.Marshaler           = Kelvin(0)
+	_ json.Unmarshaler         = (*AnyTemperature)(nil)
+)
+
+// yamlScalar возвращает функцию, которую пакеты YAML передают в
+// UnmarshalYAML для скалярного значения s.
+func yamlScalar(s string) func(any) error {
+	return func(v any) error {
+		p, ok := v.(*string)
+		if !ok {
+			return errors.New("unexpected target")
+		}
+		*p = s
+		return nil
+	}
+}
+
+// TestCelsiusUnmarshalText проверяет разбор чисел и строк с обозначением
+// шкалы с преобразованием в шкалу получателя.
+func TestCelsiusUnmarshalText(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Celsius
+		err      error
+	}{
+		{"85", 85, nil},
+		{" -40.5 ", -40.5, nil},
+		{"85°C", 85, nil},
+		{"212 F", 100, nil},
+		{"273.15K", 0, nil},
+		{"-300", 0, ErrBelowAbsoluteZero},
+		{"-1 K", 0, ErrBelowAbsoluteZero},
+		{"warm", 0, ErrInvalidFormat},
+		{"NaN", 0, ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var c Celsius
+			err := c.UnmarshalText([]byte(tt.input))
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if err == nil && !almostEqual(float64(c), float64(tt.expected), 1e-9) {
+				t.Fatalf("expected %v, got %v", tt.expected, c)
+			}
+		})
+	}
+}
+
+// TestUnmarshalJSON проверяет, что числа JSON по-прежнему разбираются, а
+// строки с обозначением шкалы допускаются.
+func TestUnmarshalJSON(t *testing.T) {
+	var cfg struct {
+		Min  Celsius        `json:"min"`
+		Max  Fahrenheit     `json:"max"`
+		Hold *Kelvin        `json:"hold"`
+		Set  AnyTemperature `json:"set"`
+		Idle AnyTemperature `json:"idle"`
+		None AnyTemperature `json:"none"`
+	}
+	data := `{"min": 5, "max": "40 C", "hold": "300K", "set": "72F", "idle": 20, "none": null}`
+	if err := json.Unmarshal([]byte(data), &cfg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Min != 5 || !almostEqual(float64(cfg.Max), 104, 1e-9) || cfg.Hold == nil || *cfg.Hold != 300 {
+		t.Errorf("got %v, %v, %v; want 5°C, 104°F, 300K", cfg.Min, cfg.Max, cfg.Hold)
+	}
+	if cfg.Set.Temperature != Fahrenheit(72) || cfg.Idle.Temperature != Celsius(20) || cfg.None.Valid() {
+		t.Errorf("got %v, %v, %v; want 72°F, 20°C, <nil>", cfg.Set, cfg.Idle, cfg.None)
+	}
+
+	if err := json.Unmarshal([]byte(`{"min": -500}`), &cfg); !errors.Is(err, ErrBelowAbsoluteZero) {
+		t.Errorf("expected error %v, got %v", ErrBelowAbsoluteZero, err)
+	}
+}
+
+// TestAnyTemperatureText проверяет сохранение шкалы при записи и разборе
+// текста.
+func TestAnyTemperatureText(t *testing.T) {
+	in := struct {
+		Setpoint AnyTemperature `json:"setpoint"`
+	}{AnyTemperature{Temperature: Reaumur(64)}}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != `{"setpoint":"64 Re"}` {
+		t.Fatalf("expected %s, got %s", `{"setpoint":"64 Re"}`, data)
+	}
+
+	var out struct {
+		Setpoint AnyTemperature `json:"setpoint"`
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Setpoint != in.Setpoint {
+		t.Fatalf("expected %v, got %v", in.Setpoint, out.Setpoint)
+	}
+
+	if _, err := (AnyTemperature{Temperature: Kelvin(-1)}).MarshalText(); !errors.Is(err, ErrBelowAbsoluteZero) {
+		t.Errorf("expected error %v, got %v", ErrBelowAbsoluteZero, err)
+	}
+}
+
+// TestUnmarshalYAML проверяет разбор скалярных значений YAML.
+func TestUnmarshalYAML(t *testing.T) {
+	var c Celsius
+	if err := c.UnmarshalYAML(yamlScalar("85°C")); err != nil || c != 85 {
+		t.Errorf("UnmarshalYAML(85°C) = %v, %v; want 85°C", c, err)
+	}
+	var k Kelvin
+	if err := k.UnmarshalYAML(yamlScalar("-10°C")); err != nil || !almostEqual(float64(k), 263.15, 1e-9) {
+		t.Errorf("UnmarshalYAML(-10°C) = %v, %v; want 263.15K", k, err)
+	}
+
+	a := AnyTemperature{DefaultScale: ScaleFahrenheit}
+	if err := a.UnmarshalYAML(yamlScalar("72")); err != nil || a.Temperature != Fahrenheit(72) {
+		t.Errorf("UnmarshalYAML(72) = %v, %v; want 72°F", a, err)
+	}
+}
+
+// TestAnyTemperatureDefaultScale проверяет, что шкала по умолчанию задается
+// для каждого значения отдельно.
+func TestAnyTemperatureDefaultScale(t *testing.T) {
+	cfg := struct {
+		Oven   AnyTemperature `json:"oven"`
+		Fridge AnyTemperature `json:"fridge"`
+		Probe  AnyTemperature `json:"probe"`
+	}{
+		Oven:   AnyTemperature{DefaultScale: ScaleFahrenheit},
+		Fridge: AnyTemperature{DefaultScale: ScaleKelvin},
+	}
+	data := `{"oven": 350, "fridge": 277, "probe": 20}`
+	if err := json.Unmarshal([]byte(data), &cfg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Oven.Temperature != Fahrenheit(350) || cfg.Fridge.Temperature != Kelvin(277) || cfg.Probe.Temperature != Celsius(20) {
+		t.Errorf("got %v, %v, %v; want 350°F, 277K, 20°C", cfg.Oven, cfg.Fridge, cfg.Probe)
+	}
+	if cfg.Oven.DefaultScale != ScaleFahrenheit {
+		t.Errorf("DefaultScale = %v, want %v", cfg.Oven.DefaultScale, ScaleFahrenheit)
+	}
+
+	// DefaultScale не записывается: значение записывается со шкалой и
+	// разбирается одинаково получателем с любой шкалой по умолчанию
+	out, err := json.Marshal(cfg.Fridge)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(out) != `"277 K"` {
+		t.Errorf("json.Marshal() = %s, want %q", out, "277 K")
+	}
+	back := AnyTemperature{DefaultScale: ScaleFahrenheit}
+	if err := json.Unmarshal(out, &back); err != nil || back.Temperature != Kelvin(277) || back.DefaultScale != ScaleFahrenheit {
+		t.Errorf("round trip = %+v, %v; want 277K with DefaultScale F", back, err)
+	}
+}
+
+// TestJSONRoundTrip проверяет, что температура записывается в JSON числом и
+// разбирается обратно без изменений.
+func TestJSONRoundTrip(t *testing.T) {
+	type reading struct {
+		C  Celsius    `json:"c"`
+		F  Fahrenheit `json:"f"`
+		K  Kelvin     `json:"k"`
+		R  Rankine    `json:"r"`
+		Re Reaumur    `json:"re"`
+		De Delisle    `json:"de"`
+		N  Newton     `json:"n"`
+	}
+	in := reading{C: 21.5, F: 70.7, K: 294.65, R: 530.37, Re: 17.2, De: 117.75, N: 7.095}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `{"c":21.5,"f":70.7,"k":294.65,"r":530.37,"re":17.2,"de":117.75,"n":7.095}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+	var out reading
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out != in {
+		t.Errorf("round trip = %+v, want %+v", out, in)
+	}
+
+	for _, v := range []any{Celsius(math.NaN()), Kelvin(math.Inf(1)), Delisle(math.Inf(-1)), AnyTemperature{Temperature: Fahrenheit(math.Inf(1))}} {
+		if _, err := json.Marshal(v); !errors.Is(err, ErrEncodingRange) {
+			t.Errorf("json.Marshal(%v) error = %v, want %v", v, err, ErrEncodingRange)
+		}
+	}
+}
+
+// TestUnmarshalTOML проверяет разбор значений TOML всех типов.
+func TestUnmarshalTOML(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected Delisle
+		err      error
+	}{
+		{int64(100), 100, nil},
+		{150.5, 150.5, nil},
+		{"0 C", 150, nil},
+		{int64(600), 0, ErrBelowAbsoluteZero},
+		{true, 0, ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		var de Delisle
+		err := de.UnmarshalTOML(tt.input)
+		if !errors.Is(err, tt.err) {
+			t.Fatalf("UnmarshalTOML(%v): expected error %v, got %v", tt.input, tt.err, err)
+		}
+		if err == nil && !almostEqual(float64(de), float64(tt.expected), 1e-9) {
+			t.Fatalf("UnmarshalTOML(%v): expected %v, got %v", tt.input, tt.expected, de)
+		}
+	}
+}
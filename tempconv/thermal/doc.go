@@ -0,0 +1,32 @@
+// Пакет thermal содержит модель теплового объекта с сосредоточенной
+// теплоемкостью для моделирования процессов нагрева и охлаждения.
+//
+// Объект описывается теплоемкостью C (Дж/К) и набором тепловых
+// сопротивлений R (К/Вт) к окружающим средам с заданными температурами.
+// Температура объекта подчиняется уравнению
+//
+//	C·dT/dt = P - Σ (T - Tₐ)/R
+//
+// где P - мощность нагревателя (Вт). Внутри шага мощность считается
+// постоянной, и уравнение решается точно, поэтому результат не зависит от
+// устойчивости численной схемы и одинаков при любом шаге.
+//
+// Модель работает с типами tempconv.Temperature: начальная температура и
+// температуры сред задаются в любых шкалах, а результат возвращается в шкале
+// начальной температуры. Метод Run строит траекторию температуры в виде
+// series.Series, что позволяет проверять регуляторы (пакет control) и
+// тревоги (пакет alarm) без оборудования.
+//
+// # Пример использования:
+//
+//	sim, err := thermal.New(thermal.Model{
+//	    Capacity: 5000,
+//	    Losses:   []thermal.Loss{{Resistance: 0.5, Ambient: tempconv.Celsius(22)}},
+//	}, tempconv.Celsius(22))
+//	if err != nil {
+//	    fmt.Println("Ошибка:", err)
+//	    return
+//	}
+//	t, _ := sim.Step(300, time.Minute)
+//	fmt.Println(t)
+package thermal
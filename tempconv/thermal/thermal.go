@@ -0,0 +1,192 @@
+package thermal
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+	"github.com/MiCkEyZzZ/tempconv/tempconv/series"
+)
+
+// Ошибки моделирования
+var (
+	ErrInvalidModel   = errors.New("недопустимые параметры модели")
+	ErrInvalidStep    = errors.New("недопустимый шаг по времени")
+	ErrNilTemperature = errors.New("не задана температура")
+)
+
+// Loss - путь теплообмена объекта с окружающей средой.
+type Loss struct {
+	// Resistance - тепловое сопротивление, К/Вт
+	Resistance float64
+	// Ambient - температура среды
+	Ambient tempconv.Temperature
+}
+
+// Model - параметры теплового объекта.
+type Model struct {
+	// Capacity - теплоемкость объекта, Дж/К
+	Capacity float64
+	// Losses - пути теплообмена с окружающими средами
+	Losses []Loss
+}
+
+// PowerFunc возвращает мощность нагревателя (Вт) на шаге, начинающемся в
+// момент at при температуре объекта t. Отрицательная мощность означает
+// охлаждение.
+type PowerFunc func(at time.Time, t tempconv.Temperature) float64
+
+// ConstantPower возвращает PowerFunc с постоянной мощностью p.
+func ConstantPower(p float64) PowerFunc {
+	return func(time.Time, tempconv.Temperature) float64 { return p }
+}
+
+// Simulator - моделирование теплового объекта во времени. Simulator не
+// безопасен для одновременного использования из нескольких горутин.
+type Simulator struct {
+	capacity    float64
+	conductance float64 // суммарная теплопроводность к средам, Вт/К
+	flux        float64 // Σ Tₐ/R, Вт
+	scale       tempconv.Scale
+	kelvin      float64 // текущая температура объекта
+	elapsed     time.Duration
+}
+
+// New создает моделирование объекта m с начальной температурой initial.
+// Температуры возвращаются в шкале initial.
+func New(m Model, initial tempconv.Temperature) (*Simulator, error) {
+	if initial == nil {
+		return nil, ErrNilTemperature
+	}
+	scale := tempconv.ScaleOf(initial)
+	if !scale.Valid() {
+		return nil, fmt.Errorf("%w: %s", tempconv.ErrUnknownScale, initial.ScaleName())
+	}
+	if !(m.Capacity > 0) || math.IsInf(m.Capacity, 0) {
+		return nil, fmt.Errorf("%w: теплоемкость %v", ErrInvalidModel, m.Capacity)
+	}
+
+	s := &Simulator{capacity: m.Capacity, scale: scale}
+	for _, l := range m.Losses {
+		if !(l.Resistance > 0) {
+			return nil, fmt.Errorf("%w: тепловое сопротивление %v", ErrInvalidModel, l.Resistance)
+		}
+		if l.Ambient == nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidModel, ErrNilTemperature)
+		}
+		ambient := float64(l.Ambient.ToKelvin())
+		if ambient < 0 {
+			return nil, fmt.Errorf("%w: %v", tempconv.ErrBelowAbsoluteZero, l.Ambient)
+		}
+		s.conductance += 1 / l.Resistance
+		s.flux += ambient / l.Resistance
+	}
+	if err := s.set(initial); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Temperature возвращает текущую температуру объекта.
+func (s *Simulator) Temperature() tempconv.Temperature {
+	return s.scale.Convert(tempconv.Kelvin(s.kelvin))
+}
+
+// SetTemperature устанавливает температуру объекта, не изменяя время
+// моделирования.
+func (s *Simulator) SetTemperature(t tempconv.Temperature) error {
+	if t == nil {
+		return ErrNilTemperature
+	}
+	return s.set(t)
+}
+
+// Elapsed возвращает время, прошедшее с начала моделирования.
+func (s *Simulator) Elapsed() time.Duration { return s.elapsed }
+
+// Equilibrium возвращает установившуюся температуру объекта при постоянной
+// мощности power. Если объект не обменивается теплом со средами, установившейся
+// температуры нет и возвращается nil.
+func (s *Simulator) Equilibrium(power float64) tempconv.Temperature {
+	if s.conductance == 0 {
+		return nil
+	}
+	return s.scale.Convert(tempconv.Kelvin((power + s.flux) / s.conductance))
+}
+
+// TimeConstant возвращает постоянную времени объекта C/ΣG или ноль, если
+// объект не обменивается теплом со средами.
+func (s *Simulator) TimeConstant() time.Duration {
+	if s.conductance == 0 {
+		return 0
+	}
+	return time.Duration(s.capacity / s.conductance * float64(time.Second))
+}
+
+// Step продвигает моделирование на интервал dt при постоянной мощности
+// нагревателя power (Вт) и возвращает новую температуру объекта. Если
+// температура опустилась бы ниже абсолютного нуля, состояние не изменяется и
+// возвращается ошибка ErrBelowAbsoluteZero.
+func (s *Simulator) Step(power float64, dt time.Duration) (tempconv.Temperature, error) {
+	if dt <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidStep, dt)
+	}
+	sec := dt.Seconds()
+
+	var k float64
+	if s.conductance == 0 {
+		k = s.kelvin + power*sec/s.capacity
+	} else {
+		eq := (power + s.flux) / s.conductance
+		k = eq + (s.kelvin-eq)*math.Exp(-s.conductance*sec/s.capacity)
+	}
+	if k < 0 || math.IsNaN(k) {
+		return nil, fmt.Errorf("%w: %.2f K", tempconv.ErrBelowAbsoluteZero, k)
+	}
+
+	s.kelvin = k
+	s.elapsed += dt
+	return s.Temperature(), nil
+}
+
+// Run моделирует объект в течение duration с шагом dt, начиная с момента
+// start, и возвращает траекторию температуры, включая начальную точку.
+// Мощность на каждом шаге задается функцией power.
+func (s *Simulator) Run(start time.Time, duration, dt time.Duration, power PowerFunc) (*series.Series, error) {
+	if dt <= 0 || duration < 0 {
+		return nil, fmt.Errorf("%w: шаг %v, длительность %v", ErrInvalidStep, dt, duration)
+	}
+	trace, err := series.New(s.scale)
+	if err != nil {
+		return nil, err
+	}
+
+	at := start
+	t := s.Temperature()
+	if err := trace.Append(at, t); err != nil {
+		return nil, err
+	}
+	for elapsed := time.Duration(0); elapsed < duration; elapsed += dt {
+		step := min(dt, duration-elapsed)
+		if t, err = s.Step(power(at, t), step); err != nil {
+			return trace, err
+		}
+		at = at.Add(step)
+		if err := trace.Append(at, t); err != nil {
+			return trace, err
+		}
+	}
+	return trace, nil
+}
+
+// set устанавливает температуру объекта с проверкой абсолютного нуля.
+func (s *Simulator) set(t tempconv.Temperature) error {
+	k := float64(t.ToKelvin())
+	if k < 0 {
+		return fmt.Errorf("%w: %v", tempconv.ErrBelowAbsoluteZero, t)
+	}
+	s.kelvin = k
+	return nil
+}
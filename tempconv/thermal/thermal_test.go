@@ -0,0 +1,176 @@
+package thermal
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+	"github.com/MiCkEyZzZ/tempconv/tempconv/alarm"
+	"github.com/MiCkEyZzZ/tempconv/tempconv/control"
+)
+
+// almostEqual проверяет, что два числа почти равны с заданной погрешностью.
+func almostEqual(a, b float64, epsilon float64) bool {
+	return math.Abs(a-b) <= epsilon
+}
+
+// t0 - начало тестового моделирования
+var t0 = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// oven - модель печи: C = 10 кДж/К, R = 0.1 К/Вт (τ = 1000 с), температура
+// помещения 20°C.
+var oven = Model{
+	Capacity: 10000,
+	Losses:   []Loss{{Resistance: 0.1, Ambient: tempconv.Celsius(20)}},
+}
+
+// TestNewErrors проверяет отклонение некорректных параметров модели.
+func TestNewErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		model   Model
+		initial tempconv.Temperature
+		wantErr error
+	}{
+		{"nil initial", oven, nil, ErrNilTemperature},
+		{"zero capacity", Model{}, tempconv.Celsius(20), ErrInvalidModel},
+		{"zero resistance", Model{Capacity: 1, Losses: []Loss{{Ambient: tempconv.Celsius(20)}}}, tempconv.Celsius(20), ErrInvalidModel},
+		{"nil ambient", Model{Capacity: 1, Losses: []Loss{{Resistance: 1}}}, tempconv.Celsius(20), ErrNilTemperature},
+		{"below absolute zero", oven, tempconv.Kelvin(-1), tempconv.ErrBelowAbsoluteZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(tt.model, tt.initial); !errors.Is(err, tt.wantErr) {
+				t.Errorf("New() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestStep проверяет точное решение для одного объекта при разных шагах.
+func TestStep(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps int
+	}{
+		{"single step", 1},
+		{"fine steps", 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sim, _ := New(oven, tempconv.Fahrenheit(68)) // 20°C
+			var got tempconv.Temperature
+			for i := 0; i < tt.steps; i++ {
+				got, _ = sim.Step(1000, 1000*time.Second/time.Duration(tt.steps))
+			}
+			// Через одну постоянную времени объект проходит 1 - 1/e пути до
+			// установившихся 120°C.
+			want := float64(tempconv.Celsius(20 + 100*(1-math.Exp(-1))).ToFahrenheit())
+			if _, ok := got.(tempconv.Fahrenheit); !ok {
+				t.Fatalf("Step() returned %s, want Fahrenheit", got.ScaleName())
+			}
+			if !almostEqual(float64(got.(tempconv.Fahrenheit)), want, 1e-9) {
+				t.Errorf("Step() = %v, want %.4f°F", got, want)
+			}
+			if sim.Elapsed() != 1000*time.Second {
+				t.Errorf("Elapsed() = %v, want 1000s", sim.Elapsed())
+			}
+		})
+	}
+}
+
+// TestModelProperties проверяет постоянную времени и установившуюся
+// температуру для объекта с несколькими путями теплообмена.
+func TestModelProperties(t *testing.T) {
+	sim, _ := New(Model{
+		Capacity: 3000,
+		Losses: []Loss{
+			{Resistance: 0.5, Ambient: tempconv.Celsius(20)},
+			{Resistance: 0.5, Ambient: tempconv.Kelvin(313.15)}, // 40°C
+		},
+	}, tempconv.Celsius(30))
+
+	if got := sim.TimeConstant(); got != 750*time.Second {
+		t.Errorf("TimeConstant() = %v, want 750s", got)
+	}
+	eq := sim.Equilibrium(100).(tempconv.Celsius)
+	if !almostEqual(float64(eq), 55, 1e-9) {
+		t.Errorf("Equilibrium(100) = %v, want 55.00°C", eq)
+	}
+}
+
+// TestAdiabatic проверяет объект без теплообмена со средой.
+func TestAdiabatic(t *testing.T) {
+	sim, _ := New(Model{Capacity: 4186}, tempconv.Celsius(20)) // 1 кг воды
+	got, err := sim.Step(4186, 10*time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !almostEqual(float64(got.(tempconv.Celsius)), 30, 1e-9) {
+		t.Errorf("Step() = %v, want 30.00°C", got)
+	}
+	if sim.Equilibrium(0) != nil || sim.TimeConstant() != 0 {
+		t.Errorf("Equilibrium() = %v, TimeConstant() = %v, want nil, 0", sim.Equilibrium(0), sim.TimeConstant())
+	}
+
+	// Охлаждение ниже абсолютного нуля отклоняется без изменения состояния.
+	if _, err := sim.Step(-1e9, time.Second); !errors.Is(err, tempconv.ErrBelowAbsoluteZero) {
+		t.Errorf("Step() error = %v, want %v", err, tempconv.ErrBelowAbsoluteZero)
+	}
+	if sim.Temperature() != got {
+		t.Errorf("Temperature() = %v, want %v", sim.Temperature(), got)
+	}
+	if _, err := sim.Step(0, 0); !errors.Is(err, ErrInvalidStep) {
+		t.Errorf("Step(dt=0) error = %v, want %v", err, ErrInvalidStep)
+	}
+}
+
+// TestRun проверяет траекторию, включая неполный последний шаг.
+func TestRun(t *testing.T) {
+	sim, _ := New(oven, tempconv.Celsius(20))
+	trace, err := sim.Run(t0, 25*time.Second, 10*time.Second, ConstantPower(0))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if trace.Len() != 4 {
+		t.Fatalf("Len() = %d, want 4", trace.Len())
+	}
+	if last := trace.At(3); !last.Time.Equal(t0.Add(25*time.Second)) || !almostEqual(last.Value, 20, 1e-9) {
+		t.Errorf("last reading = %+v, want 20°C at %v", last, t0.Add(25*time.Second))
+	}
+}
+
+// TestClosedLoop проверяет совместную работу модели с регулятором и тревогой:
+// печь выходит на уставку без срабатывания тревоги перегрева.
+func TestClosedLoop(t *testing.T) {
+	sim, _ := New(oven, tempconv.Celsius(20))
+	pid, _ := control.New(control.Config{
+		Kp: 100, Ki: 0.5,
+		Scale:     tempconv.ScaleCelsius,
+		OutputMin: 0, OutputMax: 2000,
+	}, tempconv.Fahrenheit(302)) // 150°C
+	overheat, _ := alarm.New(alarm.Config{High: tempconv.Celsius(160)})
+	raised := 0
+	overheat.OnEvent(func(alarm.Event) { raised++ })
+
+	trace, err := sim.Run(t0, 4*time.Hour, time.Second, func(at time.Time, temp tempconv.Temperature) float64 {
+		_ = overheat.Update(temp, at)
+		out, _ := pid.Step(temp, time.Second)
+		return out
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	final := trace.Temperature(trace.Len() - 1).(tempconv.Celsius)
+	if !almostEqual(float64(final), 150, 0.01) {
+		t.Errorf("final temperature = %v, want 150.00°C", final)
+	}
+	if raised != 0 {
+		t.Errorf("overheat alarm raised %d times, want 0", raised)
+	}
+}
@@ -0,0 +1,25 @@
+// Пакет weather содержит метеорологические индексы ощущаемой температуры:
+// индекс жары NOAA (регрессия Ротфуса), видимую температуру Стедмана и индекс
+// охлаждения ветром JAG/TI 2001 года.
+//
+// Функции принимают температуру воздуха в любой шкале пакета tempconv. Индекс
+// жары и видимая температура возвращаются в шкале, выбранной вызывающим кодом,
+// индекс охлаждения ветром - в шкале входной температуры.
+//
+// # Тепловой стресс:
+//
+// WBGTIndoor и WBGTOutdoor вычисляют индекс WBGT по измеренным температурам
+// естественного мокрого термометра, черного шара и воздуха, Liljegren
+// оценивает эти температуры по стандартным метеорологическим данным.
+// ClassifyISO7243 и ClassifyOSHA сравнивают WBGT с пределами ISO 7243:2017 и
+// таблицами OSHA/ACGIH.
+//
+// # Пример использования:
+//
+//	hi, err := weather.HeatIndex(tempconv.Celsius(32), 70, tempconv.ScaleCelsius)
+//	if err != nil {
+//	    fmt.Println("Ошибка:", err)
+//	    return
+//	}
+//	fmt.Println(hi) // 40.41°C
+package weather
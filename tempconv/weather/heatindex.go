@@ -0,0 +1,100 @@
+package weather
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+	"github.com/MiCkEyZzZ/tempconv/tempconv/humidity"
+)
+
+// Ошибки расчета метеорологических индексов
+var (
+	ErrInvalidWindSpeed = errors.New("недопустимая скорость ветра")
+)
+
+// Коэффициенты регрессии Ротфуса (температура в °F, влажность в процентах)
+const (
+	rothfuszC1 = -42.379
+	rothfuszC2 = 2.04901523
+	rothfuszC3 = 10.14333127
+	rothfuszC4 = -0.22475541
+	rothfuszC5 = -0.00683783
+	rothfuszC6 = -0.05481717
+	rothfuszC7 = 0.00122874
+	rothfuszC8 = 0.00085282
+	rothfuszC9 = -0.00000199
+	// rothfuszThreshold - значение упрощенной формулы (°F), начиная с которого
+	// применяется полная регрессия
+	rothfuszThreshold = 80.0
+)
+
+// Коэффициенты видимой температуры Стедмана (°C, гПа, м/с)
+const (
+	steadmanVapor  = 0.33
+	steadmanWind   = -0.70
+	steadmanOffset = -4.00
+)
+
+// HeatIndex вычисляет индекс жары по методике NWS/NOAA: для низких значений
+// используется упрощенная формула Стедмана, иначе - регрессия Ротфуса с
+// поправками на низкую и высокую влажность. Температура t может быть задана в
+// любой шкале, rh - относительная влажность в процентах. Результат
+// возвращается в шкале scale.
+func HeatIndex(t tempconv.Temperature, rh float64, scale tempconv.Scale) (tempconv.Temperature, error) {
+	if err := validate(rh, scale); err != nil {
+		return nil, err
+	}
+	return scale.Convert(tempconv.Fahrenheit(heatIndexF(float64(t.ToFahrenheit()), rh))), nil
+}
+
+// ApparentTemperature вычисляет видимую температуру Стедмана (вариант без учета
+// солнечной радиации, используемый Бюро метеорологии Австралии). rh -
+// относительная влажность в процентах, windSpeed - скорость ветра в м/с на
+// высоте 10 м. Результат возвращается в шкале scale.
+func ApparentTemperature(t tempconv.Temperature, rh, windSpeed float64, scale tempconv.Scale) (tempconv.Temperature, error) {
+	if err := validate(rh, scale); err != nil {
+		return nil, err
+	}
+	if !(windSpeed >= 0) {
+		return nil, fmt.Errorf("%w: %.2f м/с", ErrInvalidWindSpeed, windSpeed)
+	}
+	e, err := humidity.VaporPressure(t, rh, humidity.MagnusTetens)
+	if err != nil {
+		return nil, err
+	}
+	at := float64(t.ToCelsius()) + steadmanVapor*e + steadmanWind*windSpeed + steadmanOffset
+	return scale.Convert(tempconv.Celsius(at)), nil
+}
+
+// heatIndexF вычисляет индекс жары в °F по температуре tf (°F) и влажности rh.
+func heatIndexF(tf, rh float64) float64 {
+	simple := 0.5 * (tf + 61.0 + (tf-68.0)*1.2 + rh*0.094)
+	if (simple+tf)/2 < rothfuszThreshold {
+		return simple
+	}
+
+	hi := rothfuszC1 + rothfuszC2*tf + rothfuszC3*rh + rothfuszC4*tf*rh +
+		rothfuszC5*tf*tf + rothfuszC6*rh*rh + rothfuszC7*tf*tf*rh +
+		rothfuszC8*tf*rh*rh + rothfuszC9*tf*tf*rh*rh
+
+	switch {
+	case rh < 13 && tf >= 80 && tf <= 112:
+		hi -= (13 - rh) / 4 * math.Sqrt((17-math.Abs(tf-95))/17)
+	case rh > 85 && tf >= 80 && tf <= 87:
+		hi += (rh - 85) / 10 * (87 - tf) / 5
+	}
+	return hi
+}
+
+// validate проверяет относительную влажность и выбранную шкалу результата.
+func validate(rh float64, scale tempconv.Scale) error {
+	if !scale.Valid() {
+		return fmt.Errorf("%w: %v", tempconv.ErrUnknownScale, scale)
+	}
+	if !(rh > 0 && rh <= 100) {
+		return fmt.Errorf("%w: %.2f%%", humidity.ErrInvalidHumidity, rh)
+	}
+	return nil
+}
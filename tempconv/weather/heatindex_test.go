@@ -0,0 +1,87 @@
+package weather
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+	"github.com/MiCkEyZzZ/tempconv/tempconv/humidity"
+)
+
+// almostEqual проверяет, что два числа почти равны с заданной погрешностью.
+func almostEqual(a, b float64, epsilon float64) bool {
+	return math.Abs(a-b) <= epsilon
+}
+
+// TestHeatIndex проверяет индекс жары по таблице NWS, включая поправки
+// для низкой и высокой влажности.
+func TestHeatIndex(t *testing.T) {
+	tests := []struct {
+		tempF    float64
+		rh       float64
+		expected float64
+	}{
+		{70, 50, 69.05},  // упрощенная формула
+		{90, 40, 90.68},  // регрессия Ротфуса
+		{96, 65, 121.03}, // регрессия Ротфуса
+		{100, 10, 94.12}, // поправка на низкую влажность
+		{84, 90, 98.34},  // поправка на высокую влажность
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("HeatIndex %v°F %v%%", tt.tempF, tt.rh), func(t *testing.T) {
+			hi, err := HeatIndex(tempconv.Fahrenheit(tt.tempF), tt.rh, tempconv.ScaleFahrenheit)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := float64(hi.ToFahrenheit()); !almostEqual(got, tt.expected, 0.01) {
+				t.Errorf("HeatIndex() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestHeatIndexScales проверяет, что результат не зависит от шкалы входа и
+// возвращается в запрошенной шкале.
+func TestHeatIndexScales(t *testing.T) {
+	for _, s := range tempconv.Scales() {
+		t.Run(s.String(), func(t *testing.T) {
+			hi, err := HeatIndex(s.Convert(tempconv.Celsius(32)), 70, tempconv.ScaleKelvin)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if _, ok := hi.(tempconv.Kelvin); !ok {
+				t.Fatalf("HeatIndex() returned %T, want tempconv.Kelvin", hi)
+			}
+			if got := float64(hi.ToCelsius()); !almostEqual(got, 40.41, 0.01) {
+				t.Errorf("HeatIndex() = %v, want 40.41°C", got)
+			}
+		})
+	}
+}
+
+// TestApparentTemperature проверяет видимую температуру Стедмана.
+func TestApparentTemperature(t *testing.T) {
+	at, err := ApparentTemperature(tempconv.Celsius(30), 50, 3, tempconv.ScaleCelsius)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := float64(at.ToCelsius()); !almostEqual(got, 30.89, 0.01) {
+		t.Errorf("ApparentTemperature() = %v, want 30.89", got)
+	}
+}
+
+// TestErrors проверяет обработку некорректных входных данных.
+func TestErrors(t *testing.T) {
+	if _, err := HeatIndex(tempconv.Celsius(30), 120, tempconv.ScaleCelsius); !errors.Is(err, humidity.ErrInvalidHumidity) {
+		t.Errorf("expected error %v, got %v", humidity.ErrInvalidHumidity, err)
+	}
+	if _, err := HeatIndex(tempconv.Celsius(30), 50, 0); !errors.Is(err, tempconv.ErrUnknownScale) {
+		t.Errorf("expected error %v, got %v", tempconv.ErrUnknownScale, err)
+	}
+	if _, err := ApparentTemperature(tempconv.Celsius(30), 50, -1, tempconv.ScaleCelsius); !errors.Is(err, ErrInvalidWindSpeed) {
+		t.Errorf("expected error %v, got %v", ErrInvalidWindSpeed, err)
+	}
+}
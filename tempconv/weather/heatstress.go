@@ -0,0 +1,161 @@
+package weather
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// HeatStressLevel - уровень теплового стресса по WBGT.
+type HeatStressLevel int
+
+// Уровни теплового стресса
+const (
+	// HeatStressAcceptable - WBGT ниже предела для неакклиматизированных работников
+	HeatStressAcceptable HeatStressLevel = iota
+	// HeatStressAction - превышен уровень действия (предел для
+	// неакклиматизированных работников), требуются меры контроля
+	HeatStressAction
+	// HeatStressExceeded - превышен предел для акклиматизированных работников
+	HeatStressExceeded
+)
+
+// String возвращает название уровня теплового стресса.
+func (l HeatStressLevel) String() string {
+	switch l {
+	case HeatStressAcceptable:
+		return "acceptable"
+	case HeatStressAction:
+		return "action"
+	case HeatStressExceeded:
+		return "exceeded"
+	}
+	return fmt.Sprintf("HeatStressLevel(%d)", int(l))
+}
+
+// Workload - категория физической нагрузки по классификации ACGIH/OSHA.
+type Workload int
+
+// Категории нагрузки
+const (
+	// LightWork - легкая работа
+	LightWork Workload = iota
+	// ModerateWork - работа средней тяжести
+	ModerateWork
+	// HeavyWork - тяжелая работа
+	HeavyWork
+	// VeryHeavyWork - очень тяжелая работа
+	VeryHeavyWork
+)
+
+// String возвращает название категории нагрузки.
+func (w Workload) String() string {
+	switch w {
+	case LightWork:
+		return "light"
+	case ModerateWork:
+		return "moderate"
+	case HeavyWork:
+		return "heavy"
+	case VeryHeavyWork:
+		return "very heavy"
+	}
+	return fmt.Sprintf("Workload(%d)", int(w))
+}
+
+// Коэффициенты предельных значений ISO 7243:2017 (WBGT в °C, метаболизм в Вт)
+const (
+	isoAcclimatizedA   = 56.7
+	isoAcclimatizedB   = 11.5
+	isoUnacclimatizedA = 59.9
+	isoUnacclimatizedB = 14.1
+)
+
+// notRecommended - нагрузка не рекомендуется ни при каком WBGT.
+var notRecommended = math.Inf(-1)
+
+// Таблицы ACGIH TLV и уровней действия из технического руководства OSHA (°C).
+// Строки соответствуют доле работы в цикле: 75-100%, 50-75%, 25-50%, 0-25%;
+// столбцы - категориям нагрузки.
+var (
+	oshaTLV = [4][4]float64{
+		{31.0, 28.0, notRecommended, notRecommended},
+		{31.0, 29.0, 27.5, notRecommended},
+		{32.0, 30.0, 29.0, 28.0},
+		{32.5, 31.5, 30.5, 30.0},
+	}
+	oshaActionLimit = [4][4]float64{
+		{28.0, 25.0, notRecommended, notRecommended},
+		{28.5, 26.0, 24.0, notRecommended},
+		{29.5, 27.0, 25.5, 24.5},
+		{30.0, 29.0, 28.0, 27.0},
+	}
+)
+
+// ISO7243Limits возвращает предельные значения WBGT по ISO 7243:2017 для
+// скорости метаболизма metabolicRate (Вт): для неакклиматизированных и
+// акклиматизированных работников.
+func ISO7243Limits(metabolicRate float64) (unacclimatized, acclimatized tempconv.Celsius, err error) {
+	if !(metabolicRate > 0) || math.IsInf(metabolicRate, 1) {
+		return 0, 0, fmt.Errorf("%w: %.2f Вт", ErrInvalidMetabolicRate, metabolicRate)
+	}
+	m := math.Log10(metabolicRate)
+	return tempconv.Celsius(isoUnacclimatizedA - isoUnacclimatizedB*m),
+		tempconv.Celsius(isoAcclimatizedA - isoAcclimatizedB*m), nil
+}
+
+// ClassifyISO7243 определяет уровень теплового стресса по ISO 7243:2017 для
+// WBGT в любой шкале и скорости метаболизма metabolicRate (Вт).
+func ClassifyISO7243(wbgt tempconv.Temperature, metabolicRate float64) (HeatStressLevel, error) {
+	unacclimatized, acclimatized, err := ISO7243Limits(metabolicRate)
+	if err != nil {
+		return 0, err
+	}
+	return classify(wbgt.ToCelsius(), unacclimatized, acclimatized), nil
+}
+
+// OSHALimits возвращает уровень действия и TLV (ACGIH) для категории нагрузки
+// w и доли работы в цикле workFraction (0..1]. Для сочетаний, при которых
+// работа не рекомендуется, пределы равны -Inf.
+func OSHALimits(w Workload, workFraction float64) (actionLimit, tlv tempconv.Celsius, err error) {
+	if w < LightWork || w > VeryHeavyWork {
+		return 0, 0, fmt.Errorf("%w: %v", ErrInvalidWorkload, w)
+	}
+	if !(workFraction > 0 && workFraction <= 1) {
+		return 0, 0, fmt.Errorf("%w: доля работы %.2f", ErrInvalidWorkload, workFraction)
+	}
+	var row int
+	switch {
+	case workFraction > 0.75:
+		row = 0
+	case workFraction > 0.5:
+		row = 1
+	case workFraction > 0.25:
+		row = 2
+	default:
+		row = 3
+	}
+	return tempconv.Celsius(oshaActionLimit[row][w]), tempconv.Celsius(oshaTLV[row][w]), nil
+}
+
+// ClassifyOSHA определяет уровень теплового стресса по таблицам OSHA/ACGIH
+// для WBGT в любой шкале, категории нагрузки w и доли работы workFraction.
+func ClassifyOSHA(wbgt tempconv.Temperature, w Workload, workFraction float64) (HeatStressLevel, error) {
+	actionLimit, tlv, err := OSHALimits(w, workFraction)
+	if err != nil {
+		return 0, err
+	}
+	return classify(wbgt.ToCelsius(), actionLimit, tlv), nil
+}
+
+// classify сравнивает WBGT с уровнем действия и предельным значением.
+func classify(wbgt, actionLimit, limit tempconv.Celsius) HeatStressLevel {
+	switch {
+	case wbgt > limit:
+		return HeatStressExceeded
+	case wbgt > actionLimit:
+		return HeatStressAction
+	}
+	return HeatStressAcceptable
+}
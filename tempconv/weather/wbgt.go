@@ -0,0 +1,281 @@
+package weather
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// Ошибки расчета WBGT и классификации теплового стресса
+var (
+	ErrInvalidMeteorology   = errors.New("недопустимые метеорологические данные")
+	ErrNoConvergence        = errors.New("итерационный расчет не сошелся")
+	ErrInvalidWorkload      = errors.New("недопустимая категория нагрузки")
+	ErrInvalidMetabolicRate = errors.New("недопустимая скорость метаболизма")
+)
+
+// Весовые коэффициенты WBGT
+const (
+	wbgtWetBulbWeight        = 0.7
+	wbgtGlobeIndoorWeight    = 0.3
+	wbgtGlobeOutdoorWeight   = 0.2
+	wbgtDryBulbOutdoorWeight = 0.1
+)
+
+// WBGTIndoor вычисляет WBGT в помещении (без солнечной нагрузки) по
+// температурам естественного мокрого термометра и черного шара:
+// WBGT = 0.7·Tnwb + 0.3·Tg. Температуры могут быть заданы в разных шкалах,
+// результат возвращается в шкале scale.
+func WBGTIndoor(naturalWetBulb, globe tempconv.Temperature, scale tempconv.Scale) (tempconv.Temperature, error) {
+	if !scale.Valid() {
+		return nil, fmt.Errorf("%w: %v", tempconv.ErrUnknownScale, scale)
+	}
+	wbgt := wbgtWetBulbWeight*naturalWetBulb.ToCelsius() + wbgtGlobeIndoorWeight*globe.ToCelsius()
+	return scale.Convert(wbgt), nil
+}
+
+// WBGTOutdoor вычисляет WBGT вне помещения (с солнечной нагрузкой):
+// WBGT = 0.7·Tnwb + 0.2·Tg + 0.1·Ta. Результат возвращается в шкале scale.
+func WBGTOutdoor(naturalWetBulb, globe, dryBulb tempconv.Temperature, scale tempconv.Scale) (tempconv.Temperature, error) {
+	if !scale.Valid() {
+		return nil, fmt.Errorf("%w: %v", tempconv.ErrUnknownScale, scale)
+	}
+	wbgt := wbgtWetBulbWeight*naturalWetBulb.ToCelsius() +
+		wbgtGlobeOutdoorWeight*globe.ToCelsius() +
+		wbgtDryBulbOutdoorWeight*dryBulb.ToCelsius()
+	return scale.Convert(wbgt), nil
+}
+
+// Meteorology - метеорологические данные для оценки WBGT по модели Лильегрена.
+type Meteorology struct {
+	// AirTemperature - температура воздуха
+	AirTemperature tempconv.Temperature
+	// RelativeHumidity - относительная влажность в процентах
+	RelativeHumidity float64
+	// Pressure - атмосферное давление, гПа
+	Pressure float64
+	// WindSpeed - скорость ветра на высоте 2 м, м/с
+	WindSpeed float64
+	// SolarRadiation - суммарная солнечная радиация на горизонтальную поверхность, Вт/м²
+	SolarRadiation float64
+	// DirectFraction - доля прямой солнечной радиации (0..1)
+	DirectFraction float64
+	// CosZenith - косинус зенитного угла Солнца
+	CosZenith float64
+}
+
+// WBGTEstimate - результат оценки WBGT по метеорологическим данным.
+type WBGTEstimate struct {
+	// NaturalWetBulb - температура естественного мокрого термометра
+	NaturalWetBulb tempconv.Temperature
+	// Globe - температура черного шара диаметром 50.8 мм
+	Globe tempconv.Temperature
+	// DryBulb - температура воздуха
+	DryBulb tempconv.Temperature
+	// WBGT - индекс WBGT вне помещения
+	WBGT tempconv.Temperature
+}
+
+// Физические константы и параметры приборов модели Лильегрена (2008)
+const (
+	stefanBoltzmann = 5.6696e-8
+	airCp           = 1003.5
+	molarMassAir    = 28.97
+	molarMassWater  = 18.015
+	gasConstant     = 8314.34
+	airR            = gasConstant / molarMassAir
+	cpRatio         = airCp * molarMassAir / molarMassWater
+	prandtl         = airCp / (airCp + 1.25*airR)
+
+	wickEmissivity    = 0.95
+	wickAlbedo        = 0.4
+	wickDiameter      = 0.007
+	wickLength        = 0.0254
+	globeEmissivity   = 0.95
+	globeAlbedo       = 0.05
+	globeDiameter     = 0.0508
+	surfaceEmissivity = 0.999
+	surfaceAlbedo     = 0.45
+
+	minCosZenith     = 0.00873
+	minWindSpeed     = 0.13
+	liljegrenEpsilon = 0.02
+	liljegrenMaxIter = 50
+)
+
+// Liljegren оценивает температуры естественного мокрого термометра, черного
+// шара и WBGT по стандартным метеорологическим данным с помощью модели
+// Лильегрена и др. (2008). Все температуры результата возвращаются в шкале
+// scale.
+func Liljegren(m Meteorology, scale tempconv.Scale) (WBGTEstimate, error) {
+	if !scale.Valid() {
+		return WBGTEstimate{}, fmt.Errorf("%w: %v", tempconv.ErrUnknownScale, scale)
+	}
+	if err := m.validate(); err != nil {
+		return WBGTEstimate{}, err
+	}
+
+	ta := float64(m.AirTemperature.ToKelvin())
+	rh := m.RelativeHumidity / 100
+	fdir, cza := m.DirectFraction, m.CosZenith
+	if cza < minCosZenith {
+		// Солнце у горизонта: прямую радиацию считаем рассеянной
+		fdir, cza = 0, minCosZenith
+	}
+
+	tg, err := globeTemperature(ta, rh, m.Pressure, m.WindSpeed, m.SolarRadiation, fdir, cza)
+	if err != nil {
+		return WBGTEstimate{}, err
+	}
+	tnwb, err := naturalWetBulb(ta, rh, m.Pressure, m.WindSpeed, m.SolarRadiation, fdir, cza)
+	if err != nil {
+		return WBGTEstimate{}, err
+	}
+
+	wbgt := wbgtWetBulbWeight*tnwb + wbgtGlobeOutdoorWeight*tg + wbgtDryBulbOutdoorWeight*ta
+	return WBGTEstimate{
+		NaturalWetBulb: scale.Convert(tempconv.Kelvin(tnwb)),
+		Globe:          scale.Convert(tempconv.Kelvin(tg)),
+		DryBulb:        scale.Convert(tempconv.Kelvin(ta)),
+		WBGT:           scale.Convert(tempconv.Kelvin(wbgt)),
+	}, nil
+}
+
+// validate проверяет метеорологические данные.
+func (m Meteorology) validate() error {
+	switch {
+	case m.AirTemperature == nil:
+		return fmt.Errorf("%w: не задана температура воздуха", ErrInvalidMeteorology)
+	case !(m.RelativeHumidity > 0 && m.RelativeHumidity <= 100):
+		return fmt.Errorf("%w: влажность %.2f%%", ErrInvalidMeteorology, m.RelativeHumidity)
+	case !(m.Pressure > 0):
+		return fmt.Errorf("%w: давление %.2f гПа", ErrInvalidMeteorology, m.Pressure)
+	case !(m.WindSpeed >= 0):
+		return fmt.Errorf("%w: скорость ветра %.2f м/с", ErrInvalidMeteorology, m.WindSpeed)
+	case !(m.SolarRadiation >= 0):
+		return fmt.Errorf("%w: солнечная радиация %.2f Вт/м²", ErrInvalidMeteorology, m.SolarRadiation)
+	case !(m.DirectFraction >= 0 && m.DirectFraction <= 1):
+		return fmt.Errorf("%w: доля прямой радиации %.2f", ErrInvalidMeteorology, m.DirectFraction)
+	case !(m.CosZenith >= 0 && m.CosZenith <= 1):
+		return fmt.Errorf("%w: косинус зенитного угла %.2f", ErrInvalidMeteorology, m.CosZenith)
+	}
+	return nil
+}
+
+// globeTemperature итерационно находит температуру черного шара (К).
+func globeTemperature(ta, rh, pressure, speed, solar, fdir, cza float64) (float64, error) {
+	atm := atmosphereEmissivity(ta, rh)
+	prev := ta
+	for i := 0; i < liljegrenMaxIter; i++ {
+		tref := 0.5 * (prev + ta)
+		h := sphereHeatTransfer(globeDiameter, tref, pressure, speed)
+		tg := math.Pow(0.5*(atm*math.Pow(ta, 4)+surfaceEmissivity*math.Pow(ta, 4))-
+			h/(stefanBoltzmann*globeEmissivity)*(prev-ta)+
+			solar/(2*stefanBoltzmann*globeEmissivity)*(1-globeAlbedo)*
+				(fdir*(1/(2*cza)-1)+1+surfaceAlbedo), 0.25)
+		if math.Abs(tg-prev) < liljegrenEpsilon {
+			return tg, nil
+		}
+		prev = 0.9*prev + 0.1*tg
+	}
+	return 0, fmt.Errorf("%w: температура черного шара", ErrNoConvergence)
+}
+
+// naturalWetBulb итерационно находит температуру естественного мокрого
+// термометра (К).
+func naturalWetBulb(ta, rh, pressure, speed, solar, fdir, cza float64) (float64, error) {
+	atm := atmosphereEmissivity(ta, rh)
+	eair := rh * liljegrenSaturation(ta)
+	sza := math.Acos(cza)
+	prev := liljegrenDewPoint(eair)
+	for i := 0; i < liljegrenMaxIter; i++ {
+		tref := 0.5 * (prev + ta)
+		h := cylinderHeatTransfer(wickDiameter, tref, pressure, speed)
+		fatm := stefanBoltzmann*wickEmissivity*
+			(0.5*(atm*math.Pow(ta, 4)+surfaceEmissivity*math.Pow(ta, 4))-math.Pow(prev, 4)) +
+			(1-wickAlbedo)*solar*((1-fdir)*(1+0.25*wickDiameter/wickLength)+
+				fdir*(math.Tan(sza)/math.Pi+0.25*wickDiameter/wickLength)+surfaceAlbedo)
+		ewick := liljegrenSaturation(prev)
+		density := pressure * 100 / (airR * tref)
+		schmidt := airViscosity(tref) / (density * vaporDiffusivity(tref, pressure))
+		twb := ta - evaporationHeat(tref)/cpRatio*(ewick-eair)/(pressure-ewick)*
+			math.Pow(prandtl/schmidt, 0.56) + fatm/h
+		if math.Abs(twb-prev) < liljegrenEpsilon {
+			return twb, nil
+		}
+		prev = 0.9*prev + 0.1*twb
+	}
+	return 0, fmt.Errorf("%w: температура мокрого термометра", ErrNoConvergence)
+}
+
+// liljegrenSaturation возвращает давление насыщенного пара над водой (гПа) при
+// температуре tk (К) по формуле Бака с поправочным коэффициентом.
+func liljegrenSaturation(tk float64) float64 {
+	y := (tk - 273.15) / (tk - 32.18)
+	return 1.004 * 6.1121 * math.Exp(17.502*y)
+}
+
+// liljegrenDewPoint обращает liljegrenSaturation и возвращает точку росы (К).
+func liljegrenDewPoint(e float64) float64 {
+	z := math.Log(e / (1.004 * 6.1121))
+	return 273.15 + 240.97*z/(17.502-z)
+}
+
+// atmosphereEmissivity возвращает излучательную способность атмосферы.
+func atmosphereEmissivity(tk, rh float64) float64 {
+	return 0.575 * math.Pow(rh*liljegrenSaturation(tk), 0.143)
+}
+
+// airViscosity возвращает динамическую вязкость воздуха (кг/(м·с)).
+func airViscosity(tk float64) float64 {
+	const sigma, epsKappa = 3.617, 97.0
+	omega := (tk/epsKappa-2.9)/0.4*(-0.034) + 1.048
+	return 2.6693e-6 * math.Sqrt(molarMassAir*tk) / (sigma * sigma * omega)
+}
+
+// airConductivity возвращает теплопроводность воздуха (Вт/(м·К)).
+func airConductivity(tk float64) float64 {
+	return (airCp + 1.25*airR) * airViscosity(tk)
+}
+
+// vaporDiffusivity возвращает коэффициент диффузии водяного пара в воздухе
+// (м²/с) при давлении pressure (гПа).
+func vaporDiffusivity(tk, pressure float64) float64 {
+	const (
+		pcritAir, pcritWater = 36.4, 218.0
+		tcritAir, tcritWater = 132.0, 647.3
+		a, b                 = 3.640e-4, 2.334
+	)
+	pcrit13 := math.Pow(pcritAir*pcritWater, 1.0/3)
+	tcrit512 := math.Pow(tcritAir*tcritWater, 5.0/12)
+	tcrit12 := math.Sqrt(tcritAir * tcritWater)
+	mmix := math.Sqrt(1/molarMassAir + 1/molarMassWater)
+	patm := pressure / 1013.25
+	return a * math.Pow(tk/tcrit12, b) * pcrit13 * tcrit512 * mmix / patm * 1e-4
+}
+
+// evaporationHeat возвращает удельную теплоту испарения воды (Дж/кг).
+func evaporationHeat(tk float64) float64 {
+	return (313.15-tk)/30*(-71100) + 2.4073e6
+}
+
+// sphereHeatTransfer возвращает коэффициент конвективной теплоотдачи сферы
+// (Вт/(м²·К)).
+func sphereHeatTransfer(diameter, tk, pressure, speed float64) float64 {
+	density := pressure * 100 / (airR * tk)
+	re := math.Max(speed, minWindSpeed) * density * diameter / airViscosity(tk)
+	nu := 2 + 0.6*math.Sqrt(re)*math.Pow(prandtl, 0.3333)
+	return nu * airConductivity(tk) / diameter
+}
+
+// cylinderHeatTransfer возвращает коэффициент конвективной теплоотдачи
+// цилиндра в поперечном потоке (Вт/(м²·К)).
+func cylinderHeatTransfer(diameter, tk, pressure, speed float64) float64 {
+	const a, b, c = 0.56, 0.281, 0.4
+	density := pressure * 100 / (airR * tk)
+	re := math.Max(speed, minWindSpeed) * density * diameter / airViscosity(tk)
+	nu := b * math.Pow(re, 1-c) * math.Pow(prandtl, 1-a)
+	return nu * airConductivity(tk) / diameter
+}
@@ -0,0 +1,143 @@
+package weather
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// TestWBGT проверяет формулы WBGT для помещения и открытой местности при
+// входных температурах в разных шкалах.
+func TestWBGT(t *testing.T) {
+	nwb := tempconv.Celsius(25).ToFahrenheit()
+	globe := tempconv.Celsius(40).ToKelvin()
+	dry := tempconv.Celsius(32)
+
+	indoor, err := WBGTIndoor(nwb, globe, tempconv.ScaleCelsius)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := float64(indoor.ToCelsius()); !almostEqual(got, 29.5, 1e-9) {
+		t.Errorf("WBGTIndoor() = %v, want 29.5", got)
+	}
+
+	outdoor, err := WBGTOutdoor(nwb, globe, dry, tempconv.ScaleFahrenheit)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := outdoor.(tempconv.Fahrenheit); !ok {
+		t.Fatalf("WBGTOutdoor() returned %T, want tempconv.Fahrenheit", outdoor)
+	}
+	if got := float64(outdoor.ToCelsius()); !almostEqual(got, 28.7, 1e-9) {
+		t.Errorf("WBGTOutdoor() = %v, want 28.7", got)
+	}
+
+	if _, err := WBGTIndoor(nwb, globe, 0); !errors.Is(err, tempconv.ErrUnknownScale) {
+		t.Errorf("expected error %v, got %v", tempconv.ErrUnknownScale, err)
+	}
+}
+
+// TestLiljegren проверяет оценку WBGT по метеорологическим данным.
+func TestLiljegren(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        Meteorology
+		nwb      float64
+		globe    float64
+		expected float64
+	}{
+		{"день", Meteorology{tempconv.Celsius(30), 50, 1013, 2, 800, 0.8, 0.8}, 24.01, 44.25, 28.66},
+		{"ночь", Meteorology{tempconv.Celsius(30), 50, 1013, 2, 0, 0, 0}, 21.92, 29.11, 24.16},
+		{"Фаренгейт", Meteorology{tempconv.Fahrenheit(95), 40, 1010, 1, 1000, 0.85, 0.95}, 26.89, 55.45, 33.41},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := Liljegren(tt.m, tempconv.ScaleCelsius)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := float64(e.NaturalWetBulb.ToCelsius()); !almostEqual(got, tt.nwb, 0.01) {
+				t.Errorf("NaturalWetBulb = %v, want %v", got, tt.nwb)
+			}
+			if got := float64(e.Globe.ToCelsius()); !almostEqual(got, tt.globe, 0.01) {
+				t.Errorf("Globe = %v, want %v", got, tt.globe)
+			}
+			if got := float64(e.WBGT.ToCelsius()); !almostEqual(got, tt.expected, 0.01) {
+				t.Errorf("WBGT = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	bad := Meteorology{tempconv.Celsius(30), 50, 0, 2, 800, 0.8, 0.8}
+	if _, err := Liljegren(bad, tempconv.ScaleCelsius); !errors.Is(err, ErrInvalidMeteorology) {
+		t.Errorf("expected error %v, got %v", ErrInvalidMeteorology, err)
+	}
+}
+
+// TestClassifyISO7243 проверяет классификацию по ISO 7243:2017.
+func TestClassifyISO7243(t *testing.T) {
+	tests := []struct {
+		wbgt     tempconv.Temperature
+		rate     float64
+		expected HeatStressLevel
+	}{
+		// Для 300 Вт: 24.97°C без акклиматизации, 28.21°C с акклиматизацией
+		{tempconv.Celsius(24), 300, HeatStressAcceptable},
+		{tempconv.Celsius(26), 300, HeatStressAction},
+		{tempconv.Fahrenheit(84), 300, HeatStressExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("ISO 7243 %v %vW", tt.wbgt, tt.rate), func(t *testing.T) {
+			got, err := ClassifyISO7243(tt.wbgt, tt.rate)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("ClassifyISO7243() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	if _, err := ClassifyISO7243(tempconv.Celsius(24), 0); !errors.Is(err, ErrInvalidMetabolicRate) {
+		t.Errorf("expected error %v, got %v", ErrInvalidMetabolicRate, err)
+	}
+}
+
+// TestClassifyOSHA проверяет классификацию по таблицам OSHA/ACGIH.
+func TestClassifyOSHA(t *testing.T) {
+	tests := []struct {
+		wbgt     tempconv.Temperature
+		workload Workload
+		fraction float64
+		expected HeatStressLevel
+	}{
+		{tempconv.Celsius(24), ModerateWork, 1, HeatStressAcceptable},
+		{tempconv.Celsius(27), ModerateWork, 1, HeatStressAction},
+		{tempconv.Celsius(29), ModerateWork, 1, HeatStressExceeded},
+		{tempconv.Celsius(29), ModerateWork, 0.2, HeatStressAcceptable},
+		{tempconv.Celsius(15), HeavyWork, 1, HeatStressExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("OSHA %v %v %v", tt.wbgt, tt.workload, tt.fraction), func(t *testing.T) {
+			got, err := ClassifyOSHA(tt.wbgt, tt.workload, tt.fraction)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("ClassifyOSHA() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	if _, err := ClassifyOSHA(tempconv.Celsius(24), Workload(9), 1); !errors.Is(err, ErrInvalidWorkload) {
+		t.Errorf("expected error %v, got %v", ErrInvalidWorkload, err)
+	}
+	if _, err := ClassifyOSHA(tempconv.Celsius(24), LightWork, 0); !errors.Is(err, ErrInvalidWorkload) {
+		t.Errorf("expected error %v, got %v", ErrInvalidWorkload, err)
+	}
+}
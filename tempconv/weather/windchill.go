@@ -0,0 +1,144 @@
+package weather
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// Ошибки расчета индекса охлаждения ветром
+var (
+	ErrWindChillRange = errors.New("условия вне области применимости формулы охлаждения ветром")
+)
+
+// SpeedUnit - единица измерения скорости ветра.
+type SpeedUnit int
+
+// Поддерживаемые единицы скорости
+const (
+	// MetersPerSecond - метры в секунду
+	MetersPerSecond SpeedUnit = iota
+	// KilometersPerHour - километры в час
+	KilometersPerHour
+	// MilesPerHour - мили в час
+	MilesPerHour
+	// Knots - узлы
+	Knots
+)
+
+// Константы формулы JAG/TI 2001 года
+const (
+	// windChillMaxC - максимальная температура воздуха, для которой определен индекс (°C)
+	windChillMaxC = 10.0
+	// windChillMinKmh - минимальная скорость ветра, для которой определен индекс (км/ч)
+	windChillMinKmh = 4.8
+	// windChillExponent - показатель степени скорости ветра
+	windChillExponent = 0.16
+
+	// Коэффициенты в единицах Environment Canada (°C, км/ч)
+	windChillC1 = 13.12
+	windChillC2 = 0.6215
+	windChillC3 = -11.37
+	windChillC4 = 0.3965
+
+	// Коэффициенты в единицах NWS (°F, мили/ч)
+	windChillF1 = 35.74
+	windChillF2 = 0.6215
+	windChillF3 = -35.75
+	windChillF4 = 0.4275
+)
+
+// Коэффициенты пересчета скорости в км/ч
+const (
+	msToKmh  = 3.6
+	mphToKmh = 1.609344
+	ktToKmh  = 1.852
+)
+
+// String возвращает обозначение единицы скорости.
+func (u SpeedUnit) String() string {
+	switch u {
+	case MetersPerSecond:
+		return "м/с"
+	case KilometersPerHour:
+		return "км/ч"
+	case MilesPerHour:
+		return "mph"
+	case Knots:
+		return "уз"
+	}
+	return fmt.Sprintf("SpeedUnit(%d)", int(u))
+}
+
+// ToKilometersPerHour переводит скорость v, заданную в единицах u, в км/ч.
+func (u SpeedUnit) ToKilometersPerHour(v float64) (float64, error) {
+	switch u {
+	case MetersPerSecond:
+		return v * msToKmh, nil
+	case KilometersPerHour:
+		return v, nil
+	case MilesPerHour:
+		return v * mphToKmh, nil
+	case Knots:
+		return v * ktToKmh, nil
+	}
+	return 0, fmt.Errorf("%w: неизвестная единица %v", ErrInvalidWindSpeed, u)
+}
+
+// WindChill вычисляет индекс охлаждения ветром по формуле JAG/TI 2001 года в
+// форме Environment Canada (°C, км/ч на высоте 10 м). Результат возвращается в
+// шкале t. Формула определена только для температур не выше 10°C и скорости
+// ветра не ниже 4.8 км/ч; вне этой области возвращается ErrWindChillRange.
+func WindChill(t tempconv.Temperature, windSpeed float64, unit SpeedUnit) (tempconv.Temperature, error) {
+	tc, kmh, err := windChillInput(t, windSpeed, unit)
+	if err != nil {
+		return nil, err
+	}
+	v := math.Pow(kmh, windChillExponent)
+	wc := windChillC1 + windChillC2*tc + windChillC3*v + windChillC4*tc*v
+	return inScaleOf(t, tempconv.Celsius(wc)), nil
+}
+
+// WindChillNWS вычисляет индекс охлаждения ветром по той же формуле JAG/TI в
+// форме NWS (°F, мили/ч). Результат совпадает с WindChill с точностью до
+// округления коэффициентов и возвращается в шкале t.
+func WindChillNWS(t tempconv.Temperature, windSpeed float64, unit SpeedUnit) (tempconv.Temperature, error) {
+	_, kmh, err := windChillInput(t, windSpeed, unit)
+	if err != nil {
+		return nil, err
+	}
+	tf := float64(t.ToFahrenheit())
+	v := math.Pow(kmh/mphToKmh, windChillExponent)
+	wc := windChillF1 + windChillF2*tf + windChillF3*v + windChillF4*tf*v
+	return inScaleOf(t, tempconv.Fahrenheit(wc)), nil
+}
+
+// windChillInput проверяет входные данные индекса охлаждения ветром и
+// возвращает температуру в °C и скорость ветра в км/ч.
+func windChillInput(t tempconv.Temperature, windSpeed float64, unit SpeedUnit) (tc, kmh float64, err error) {
+	if !(windSpeed >= 0) {
+		return 0, 0, fmt.Errorf("%w: %.2f %v", ErrInvalidWindSpeed, windSpeed, unit)
+	}
+	kmh, err = unit.ToKilometersPerHour(windSpeed)
+	if err != nil {
+		return 0, 0, err
+	}
+	tc = float64(t.ToCelsius())
+	if tc > windChillMaxC {
+		return 0, 0, fmt.Errorf("%w: %v выше %.0f°C", ErrWindChillRange, t, windChillMaxC)
+	}
+	if kmh < windChillMinKmh {
+		return 0, 0, fmt.Errorf("%w: ветер %.2f км/ч слабее %.1f км/ч", ErrWindChillRange, kmh, windChillMinKmh)
+	}
+	return tc, kmh, nil
+}
+
+// inScaleOf возвращает температуру v в шкале температуры t.
+func inScaleOf(t, v tempconv.Temperature) tempconv.Temperature {
+	if s := tempconv.ScaleOf(t); s.Valid() {
+		return s.Convert(v)
+	}
+	return v
+}
@@ -0,0 +1,74 @@
+package weather
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/MiCkEyZzZ/tempconv/tempconv"
+)
+
+// TestWindChill проверяет индекс охлаждения ветром по таблицам Environment
+// Canada и NWS.
+func TestWindChill(t *testing.T) {
+	tests := []struct {
+		temp     tempconv.Temperature
+		speed    float64
+		unit     SpeedUnit
+		expected float64
+	}{
+		{tempconv.Celsius(-10), 20, KilometersPerHour, -17.87},
+		{tempconv.Celsius(0), 10, MetersPerSecond, -7.05},
+		{tempconv.Fahrenheit(0), 15, MilesPerHour, -28.58},
+		{tempconv.Celsius(-30), 10, Knots, -42.64},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("WindChill %v %v %v", tt.temp, tt.speed, tt.unit), func(t *testing.T) {
+			wc, err := WindChill(tt.temp, tt.speed, tt.unit)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if wc.ScaleName() != tt.temp.ScaleName() {
+				t.Errorf("ScaleName() = %v, want %v", wc.ScaleName(), tt.temp.ScaleName())
+			}
+			if got := float64(wc.ToCelsius()); !almostEqual(got, tt.expected, 0.01) {
+				t.Errorf("WindChill() = %v, want %v°C", got, tt.expected)
+			}
+			nws, err := WindChillNWS(tt.temp, tt.speed, tt.unit)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := float64(nws.ToCelsius()); !almostEqual(got, tt.expected, 0.1) {
+				t.Errorf("WindChillNWS() = %v, want %v°C", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestWindChillRange проверяет ошибки вне области применимости формулы.
+func TestWindChillRange(t *testing.T) {
+	tests := []struct {
+		temp  tempconv.Temperature
+		speed float64
+		unit  SpeedUnit
+		err   error
+	}{
+		{tempconv.Celsius(11), 20, KilometersPerHour, ErrWindChillRange},
+		{tempconv.Fahrenheit(51), 20, MilesPerHour, ErrWindChillRange},
+		{tempconv.Celsius(-10), 4, KilometersPerHour, ErrWindChillRange},
+		{tempconv.Celsius(-10), -1, KilometersPerHour, ErrInvalidWindSpeed},
+		{tempconv.Celsius(-10), 10, SpeedUnit(9), ErrInvalidWindSpeed},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("WindChill %v %v %v", tt.temp, tt.speed, tt.unit), func(t *testing.T) {
+			if _, err := WindChill(tt.temp, tt.speed, tt.unit); !errors.Is(err, tt.err) {
+				t.Errorf("expected error %v, got %v", tt.err, err)
+			}
+			if _, err := WindChillNWS(tt.temp, tt.speed, tt.unit); !errors.Is(err, tt.err) {
+				t.Errorf("expected error %v, got %v", tt.err, err)
+			}
+		})
+	}
+}